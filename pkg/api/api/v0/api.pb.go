@@ -39,10 +39,15 @@ type ApiMessage struct {
 	// The version of the kind's api.
 	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	// The message definition to send to the kind's api.
-	Def                  *types.Any `protobuf:"bytes,3,opt,name=def,proto3" json:"def,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Def *types.Any `protobuf:"bytes,3,opt,name=def,proto3" json:"def,omitempty"`
+	// The optional identifier other messages in the same list can depend on.
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// The identifiers of messages that must succeed before this message is
+	// processed. Messages without dependencies depend on all preceding messages.
+	DependsOn            []string `protobuf:"bytes,5,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApiMessage) Reset()      { *m = ApiMessage{} }
@@ -98,6 +103,20 @@ func (m *ApiMessage) GetDef() *types.Any {
 	return nil
 }
 
+func (m *ApiMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiMessage) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
 // ApiMessageList wraps a sequence of api messages.
 type ApiMessageList struct {
 	// The messages.
@@ -154,27 +173,29 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/api/v0/api.proto", fileDescriptor_6674c7b102f64764) }
 
 var fileDescriptor_6674c7b102f64764 = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x6b, 0x82, 0xf8, 0xe3, 0x4a, 0x20, 0xac, 0x22, 0x99, 0x4a, 0x98, 0xd0, 0x01, 0x55,
-	0x42, 0xc4, 0xa8, 0x9d, 0x19, 0xca, 0x0c, 0x4b, 0x47, 0x36, 0xa7, 0x71, 0x8d, 0xd5, 0xc4, 0x17,
-	0xc5, 0x49, 0xa5, 0x6e, 0x3c, 0x0e, 0x8f, 0xc2, 0xc8, 0xc8, 0x48, 0xf3, 0x04, 0x8c, 0x8c, 0x28,
-	0x0e, 0xa5, 0x4d, 0xc5, 0x60, 0xf9, 0x3e, 0x7f, 0x3f, 0x9d, 0x4f, 0xdf, 0x61, 0x9a, 0xce, 0x14,
-	0x17, 0xa9, 0x76, 0x67, 0x7e, 0x5b, 0x5d, 0x41, 0x9a, 0x41, 0x0e, 0xc4, 0x13, 0xa9, 0xee, 0x76,
-	0x14, 0x28, 0x70, 0x9a, 0x57, 0x55, 0x6d, 0x75, 0xcf, 0x14, 0x80, 0x8a, 0x25, 0x77, 0x2a, 0x2c,
-	0xa6, 0x5c, 0x98, 0xc5, 0xaf, 0x75, 0x0a, 0x96, 0x87, 0x85, 0x8e, 0xa3, 0x46, 0xb3, 0xee, 0x39,
-	0x58, 0x9e, 0x88, 0xc9, 0xb3, 0x36, 0x92, 0xeb, 0x44, 0x28, 0xd9, 0xb4, 0x2f, 0x36, 0xec, 0xac,
-	0x30, 0xb9, 0x4e, 0xb6, 0x00, 0x1f, 0x2c, 0x9f, 0x80, 0xc9, 0x85, 0x36, 0x32, 0xe3, 0x61, 0x61,
-	0xa2, 0x78, 0x8b, 0xb8, 0x6c, 0x10, 0xff, 0x35, 0xe9, 0x85, 0x18, 0x8f, 0x52, 0xfd, 0x28, 0xad,
-	0x15, 0x4a, 0x12, 0x82, 0x77, 0x67, 0xda, 0x44, 0x14, 0xf9, 0xa8, 0x7f, 0x38, 0x76, 0x35, 0xa1,
-	0x78, 0x7f, 0x2e, 0x33, 0xab, 0xc1, 0xd0, 0x1d, 0xf7, 0xbc, 0x92, 0xe4, 0x0a, 0x7b, 0x91, 0x9c,
-	0x52, 0xcf, 0x47, 0xfd, 0xf6, 0xa0, 0x13, 0xd4, 0x01, 0x04, 0xab, 0x00, 0x82, 0x91, 0x59, 0x8c,
-	0x2b, 0xa0, 0x77, 0x87, 0x8f, 0xd6, 0x7f, 0x3c, 0x68, 0x9b, 0x93, 0x6b, 0x7c, 0x90, 0xd4, 0xd2,
-	0x52, 0xe4, 0x7b, 0xfd, 0xf6, 0xe0, 0x38, 0xa8, 0x66, 0x5a, 0x63, 0xe3, 0x3f, 0xe0, 0x7e, 0xf8,
-	0xb1, 0x64, 0xad, 0xaf, 0x25, 0x43, 0xdf, 0x4b, 0xd6, 0x7a, 0x29, 0x19, 0x7a, 0x2d, 0x19, 0x7a,
-	0x2b, 0x19, 0x7a, 0x2f, 0x19, 0xfa, 0x2c, 0x19, 0x7a, 0x3a, 0x11, 0x71, 0x7e, 0x03, 0x76, 0x63,
-	0x67, 0xe1, 0x9e, 0x1b, 0x63, 0xf8, 0x13, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x41, 0xe7, 0xee, 0xcc,
-	0x01, 0x00, 0x00,
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x4e, 0xfb, 0x30,
+	0x10, 0xc7, 0xeb, 0xa6, 0xbf, 0x3f, 0x75, 0xa5, 0x22, 0xac, 0x22, 0x99, 0x4a, 0x35, 0xa1, 0x03,
+	0x8a, 0x84, 0x88, 0x51, 0x3b, 0x33, 0x94, 0x19, 0x84, 0x94, 0x91, 0x05, 0x39, 0xb5, 0x1b, 0xac,
+	0x26, 0x76, 0x14, 0x27, 0x95, 0xba, 0xf1, 0x0a, 0xbc, 0x05, 0x8f, 0xc2, 0xc8, 0xc8, 0x48, 0xf3,
+	0x04, 0x8c, 0x8c, 0x28, 0x6e, 0x4b, 0xff, 0x88, 0xc1, 0xf2, 0x7d, 0xef, 0xfb, 0xf1, 0xe9, 0x7c,
+	0x07, 0x71, 0x3a, 0x8d, 0x28, 0x4b, 0xa5, 0x3d, 0xb3, 0xcb, 0xea, 0xf2, 0xd3, 0x4c, 0xe7, 0x1a,
+	0x39, 0x2c, 0x95, 0xdd, 0x4e, 0xa4, 0x23, 0x6d, 0x35, 0xad, 0xa2, 0xa5, 0xd5, 0x3d, 0x8e, 0xb4,
+	0x8e, 0x62, 0x41, 0xad, 0x0a, 0x8b, 0x09, 0x65, 0x6a, 0xbe, 0xb2, 0x8e, 0xb4, 0xa1, 0x61, 0x21,
+	0x63, 0xbe, 0x53, 0xac, 0xdb, 0xd3, 0x86, 0x26, 0x6c, 0xfc, 0x28, 0x95, 0xa0, 0x32, 0x61, 0x91,
+	0xd8, 0xb5, 0x4f, 0xb6, 0xec, 0xac, 0x50, 0xb9, 0x4c, 0xf6, 0x00, 0x57, 0x1b, 0x3a, 0xd6, 0x2a,
+	0x67, 0x52, 0x89, 0x8c, 0x86, 0x85, 0xe2, 0xf1, 0x1e, 0x71, 0xba, 0x43, 0xfc, 0x56, 0xa4, 0xff,
+	0x0c, 0x20, 0x1c, 0xa5, 0xf2, 0x56, 0x18, 0xc3, 0x22, 0x81, 0x10, 0x6c, 0x4c, 0xa5, 0xe2, 0x18,
+	0xb8, 0xc0, 0x6b, 0x06, 0x36, 0x46, 0x18, 0xfe, 0x9b, 0x89, 0xcc, 0x48, 0xad, 0x70, 0xdd, 0xa6,
+	0xd7, 0x12, 0x9d, 0x41, 0x87, 0x8b, 0x09, 0x76, 0x5c, 0xe0, 0xb5, 0x06, 0x1d, 0x7f, 0x39, 0x01,
+	0x7f, 0x3d, 0x01, 0x7f, 0xa4, 0xe6, 0x41, 0x05, 0xa0, 0x36, 0xac, 0x4b, 0x8e, 0x1b, 0xf6, 0x71,
+	0x5d, 0x72, 0xd4, 0x83, 0x90, 0x8b, 0x54, 0x28, 0x6e, 0x1e, 0xb4, 0xc2, 0x7f, 0x5c, 0xc7, 0x6b,
+	0x06, 0xcd, 0x55, 0xe6, 0x4e, 0xf5, 0xaf, 0x60, 0x7b, 0xd3, 0xd2, 0x8d, 0x34, 0x39, 0x3a, 0x87,
+	0xff, 0x93, 0xa5, 0x34, 0x18, 0xb8, 0x8e, 0xd7, 0x1a, 0x1c, 0xf8, 0xd5, 0x1f, 0x36, 0x58, 0xf0,
+	0x03, 0x5c, 0x0f, 0xdf, 0x17, 0xa4, 0xf6, 0xb9, 0x20, 0xe0, 0x6b, 0x41, 0x6a, 0x4f, 0x25, 0x01,
+	0x2f, 0x25, 0x01, 0xaf, 0x25, 0x01, 0x6f, 0x25, 0x01, 0x1f, 0x25, 0x01, 0xf7, 0x87, 0x2c, 0xce,
+	0x2f, 0xb4, 0xd9, 0xda, 0x71, 0xf8, 0xd7, 0x76, 0x3d, 0xfc, 0x1e, 0x00, 0xf1, 0xfd, 0xda, 0xa9,
+	0xfc, 0x01, 0x00, 0x00,
 }
 
 func (this *ApiMessage) Equal(that interface{}) bool {
@@ -205,6 +226,17 @@ func (this *ApiMessage) Equal(that interface{}) bool {
 	if !this.Def.Equal(that1.Def) {
 		return false
 	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if len(this.DependsOn) != len(that1.DependsOn) {
+		return false
+	}
+	for i := range this.DependsOn {
+		if this.DependsOn[i] != that1.DependsOn[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -246,13 +278,15 @@ func (this *ApiMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 9)
 	s = append(s, "&v0.ApiMessage{")
 	s = append(s, "Kind: "+fmt.Sprintf("%#v", this.Kind)+",\n")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	if this.Def != nil {
 		s = append(s, "Def: "+fmt.Sprintf("%#v", this.Def)+",\n")
 	}
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "DependsOn: "+fmt.Sprintf("%#v", this.DependsOn)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -306,6 +340,22 @@ func (m *ApiMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintApi(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Def != nil {
 		{
 			size, err := m.Def.MarshalToSizedBuffer(dAtA[:i])
@@ -405,6 +455,16 @@ func (m *ApiMessage) Size() (n int) {
 		l = m.Def.Size()
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -443,6 +503,8 @@ func (this *ApiMessage) String() string {
 		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`Def:` + strings.Replace(fmt.Sprintf("%v", this.Def), "Any", "types.Any", 1) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`DependsOn:` + fmt.Sprintf("%v", this.DependsOn) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -601,6 +663,70 @@ func (m *ApiMessage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
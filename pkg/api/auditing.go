@@ -0,0 +1,285 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Filename of the audit journal of a service within its root directory.
+const AUDIT_JOURNAL_FILENAME = "audit.jsonl"
+
+// File permission bits of created audit journals.
+const _AUDIT_JOURNAL_MODE = 0600
+
+// Maximum size of a single record read from an audit journal.
+const _MAX_AUDIT_RECORD_BYTES = 16 * 1024 * 1024
+
+// Json names of request fields that identify the objects a request operates on.
+var auditIdFields = map[string]bool{
+	"id":        true,
+	"image":     true,
+	"imageDir":  true,
+	"bundleDir": true,
+}
+
+// AuditRecord is a single record of an audit journal, describing a completed
+// call of a mutating method.
+type AuditRecord struct {
+	Time       time.Time       `json:"time"`
+	Caller     string          `json:"caller"`
+	Method     string          `json:"method"`
+	Kind       string          `json:"kind"`
+	Version    string          `json:"version"`
+	Request    json.RawMessage `json:"request"`
+	Ids        []string        `json:"ids,omitempty"` // Ids of the objects the request operates on.
+	Code       string          `json:"code"`
+	Error      string          `json:"error,omitempty"`
+	DurationMs float64         `json:"durationMs"`
+}
+
+// auditJournal appends records to a journal file.
+type auditJournal struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// OpenAuditJournal opens the audit journal file that the mutating calls of the
+// service kind are recorded to, creating it if necessary. Any journal already
+// open for the service is closed.
+func (ctxt *ApiServiceContext) OpenAuditJournal(kind, filename string) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, _AUDIT_JOURNAL_MODE)
+	if err != nil {
+		return err
+	}
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if journal, ok := ctxt.journals[kind]; ok {
+		journal.close()
+	}
+	ctxt.journals[kind] = &auditJournal{file: file}
+	return nil
+}
+
+// auditJournal returns the audit journal of the service kind, or nil if none is open.
+func (ctxt *ApiServiceContext) auditJournal(kind string) *auditJournal {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	return ctxt.journals[kind]
+}
+
+// closeAuditJournal closes the audit journal of the service kind, if open.
+func (ctxt *ApiServiceContext) closeAuditJournal(kind string) {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if journal, ok := ctxt.journals[kind]; ok {
+		journal.close()
+		delete(ctxt.journals, kind)
+	}
+}
+
+// closeUnservedAuditJournals closes the audit journals of the service kinds
+// that are not served at any address.
+func (ctxt *ApiServiceContext) closeUnservedAuditJournals() {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	for kind, journal := range ctxt.journals {
+		served := false
+		for _, a := range ctxt.addrs {
+			if _, ok := a.served[kind]; ok {
+				served = true
+			}
+		}
+		if !served {
+			journal.close()
+			delete(ctxt.journals, kind)
+		}
+	}
+}
+
+// append writes the record to the journal as a single line.
+func (journal *auditJournal) append(record *AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	if journal.file == nil {
+		return os.ErrClosed
+	}
+	_, err = journal.file.Write(append(data, '\n'))
+	return err
+}
+
+// close closes the journal file.
+func (journal *auditJournal) close() {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	if journal.file != nil {
+		journal.file.Close()
+		journal.file = nil
+	}
+}
+
+// auditUnaryInterceptor returns a server interceptor that records each call of
+// a mutating method of a service to its audit journal, if one is open. Calls
+// rejected by authorization and calls whose handler panics are recorded too.
+// Callers not identified by an authorization policy are recorded by their peer
+// address, or by the address of their HTTP client if forwarded by a gateway.
+func auditUnaryInterceptor(ctxt *ApiServiceContext) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (resp interface{}, err error) {
+
+		kind := methodServiceKind(info.FullMethod)
+		method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		if isReadOnlyMethod(method) {
+			return handler(ctx, req)
+		}
+		holder := &authCallerHolder{}
+		ctx = context.WithValue(ctx, authCallerKey{}, holder)
+		start := time.Now()
+		defer func() {
+			// Record a panicking call as failed, then let recovery handle the panic.
+			r := recover()
+			if r != nil {
+				err = status.Errorf(codes.Internal, "panic in %s", info.FullMethod)
+			}
+			recordAuditCall(ctx, ctxt.auditJournal(kind), info.FullMethod, req, holder.caller, start, err)
+			if r != nil {
+				panic(r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// recordAuditCall appends the record of a completed call to the journal, if
+// not nil.
+func recordAuditCall(ctx context.Context, journal *auditJournal, fullMethod string, req interface{},
+	caller string, start time.Time, err error) {
+
+	if journal != nil {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if client, ok := gatewayClient(md); ok && caller == "" {
+			caller = client
+		} else if p, ok := peer.FromContext(ctx); ok && caller == "" && p.Addr != nil {
+			caller = p.Addr.String()
+		}
+		if record, recordErr := newAuditRecord(fullMethod, req, caller, start, err); recordErr == nil {
+			journal.append(record)
+		}
+	}
+}
+
+// newAuditRecord returns the audit record of a completed call.
+func newAuditRecord(fullMethod string, req interface{}, caller string, start time.Time,
+	err error) (*AuditRecord, error) {
+
+	record := &AuditRecord{
+		Time:       start.UTC(),
+		Caller:     caller,
+		Method:     fullMethod,
+		Code:       status.Code(err).String(),
+		DurationMs: float64(time.Since(start)) / float64(time.Millisecond),
+	}
+	if err != nil {
+		record.Error = status.Convert(err).Message()
+	}
+	if msg, ok := req.(proto.Message); ok {
+		kind, version, _, err := marshalKind(msg)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+			return nil, err
+		}
+		record.Kind, record.Version, record.Request = kind, version, buf.Bytes()
+		var fields map[string]interface{}
+		if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+			return nil, err
+		}
+		record.Ids = auditIds(fields, nil)
+		sort.Strings(record.Ids)
+	}
+	return record, nil
+}
+
+// auditIds appends the values of the id fields of the json value to ids.
+func auditIds(value interface{}, ids []string) []string {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, field := range value {
+			if id, ok := field.(string); ok && auditIdFields[name] && id != "" {
+				ids = append(ids, id)
+			} else {
+				ids = auditIds(field, ids)
+			}
+		}
+	case []interface{}:
+		for _, elem := range value {
+			ids = auditIds(elem, ids)
+		}
+	}
+	return ids
+}
+
+// QueryAuditJournal reads the records of the audit journal file that operate on
+// the object with the id, if not empty, and that were made within the time
+// range. A zero since or until leaves the range open at that end.
+func QueryAuditJournal(filename, id string, since, until time.Time) ([]*AuditRecord, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var records []*AuditRecord
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, _MAX_AUDIT_RECORD_BYTES)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		record := &AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, err
+		}
+		if (!since.IsZero() && record.Time.Before(since)) || (!until.IsZero() && record.Time.After(until)) {
+			continue
+		}
+		if id != "" && !record.hasId(id) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+// hasId returns whether the record operates on the object with the id.
+func (record *AuditRecord) hasId(id string) bool {
+	for _, recordId := range record.Ids {
+		if recordId == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,357 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// Roles that authorization policies grant callers.
+const (
+	AUTH_ROLE_READ_ONLY = "read-only"
+	AUTH_ROLE_OPERATOR  = "operator"
+	AUTH_ROLE_ADMIN     = "admin"
+)
+
+// Metadata key of the bearer token sent with each gRPC call.
+const AUTHORIZATION_METADATA_KEY = "authorization"
+
+// Prefix of the bearer token in the authorization metadata.
+const _BEARER_PREFIX = "Bearer "
+
+// Method name that allows every method of a service.
+const _AUTH_ALL_METHODS = "*"
+
+// Name of callers identified by the internal token of the context.
+const _AUTH_INTERNAL_CALLER = "internal"
+
+// Name of callers not identified by the policy that are granted the default role.
+const _AUTH_ANONYMOUS_CALLER = "anonymous"
+
+// Number of random bytes in the internal token of a context.
+const _INTERNAL_TOKEN_BYTES = 32
+
+// Methods that each role may call unless overridden by the policy.
+var defaultAuthRoleMethods = map[string][]string{
+	AUTH_ROLE_READ_ONLY: readOnlyMethods,
+	AUTH_ROLE_OPERATOR:  {"List", "QueryState", "Start", "Kill"},
+	AUTH_ROLE_ADMIN:     {_AUTH_ALL_METHODS},
+}
+
+// apiAuthPolicyMessage interface represents service messages that can specify
+// the authorization policy of a served service.
+type apiAuthPolicyMessage interface {
+	GetApiAuthPolicyFile() string
+}
+
+// apiAuthPolicy grants the callers of a service a role, which determines the
+// methods of the service they may call.
+type apiAuthPolicy struct {
+	Callers     []*apiAuthCaller    `yaml:"callers"`
+	DefaultRole string              `yaml:"defaultRole"` // Role of unidentified callers, or none if empty.
+	Roles       map[string][]string `yaml:"roles"`       // Methods by role, overriding the defaults.
+}
+
+// apiAuthCaller identifies a caller by bearer token, or by the user or group
+// id of the peer process on unix domain sockets.
+type apiAuthCaller struct {
+	Name  string  `yaml:"name"`
+	Role  string  `yaml:"role"`
+	Token string  `yaml:"token"`
+	Uid   *uint32 `yaml:"uid"`
+	Gid   *uint32 `yaml:"gid"`
+}
+
+// authCallerKey is the context key of the holder of the name of the caller of
+// a gRPC call.
+type authCallerKey struct{}
+
+// authCallerHolder holds the name of the caller of a gRPC call once identified
+// by the authorization policy, so that interceptors that run before the call
+// is authorized can see it afterwards.
+type authCallerHolder struct {
+	caller string
+}
+
+// AuthCaller returns the name of the caller of the gRPC call of the context as
+// identified by the authorization policy, or an empty string if there is none.
+func AuthCaller(ctx context.Context) string {
+	if holder, ok := ctx.Value(authCallerKey{}).(*authCallerHolder); ok {
+		return holder.caller
+	}
+	return ""
+}
+
+// loadAuthPolicy reads the authorization policy file of the message, or
+// returns nil if it specifies none.
+func loadAuthPolicy(msg ApiServiceMessage) (*apiAuthPolicy, error) {
+	policyMsg, ok := msg.(apiAuthPolicyMessage)
+	if !ok || policyMsg.GetApiAuthPolicyFile() == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(policyMsg.GetApiAuthPolicyFile())
+	if err != nil {
+		return nil, err
+	}
+	policy := &apiAuthPolicy{}
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return nil, err
+	}
+	roles := make(map[string][]string)
+	for role, methods := range defaultAuthRoleMethods {
+		roles[role] = methods
+	}
+	for role, methods := range policy.Roles {
+		roles[role] = methods
+	}
+	policy.Roles = roles
+	if _, ok := roles[policy.DefaultRole]; !ok && policy.DefaultRole != "" {
+		return nil, errors.New("unknown default role: " + policy.DefaultRole)
+	}
+	for _, caller := range policy.Callers {
+		if _, ok := roles[caller.Role]; !ok {
+			return nil, fmt.Errorf("unknown role of caller %s: %s", caller.Name, caller.Role)
+		} else if caller.Token == "" && caller.Uid == nil && caller.Gid == nil {
+			return nil, errors.New("no token, uid or gid for caller: " + caller.Name)
+		}
+	}
+	return policy, nil
+}
+
+// identify returns the name and role of the caller of the gRPC call of the
+// context. Callers that present a bearer token are identified by it, and
+// otherwise by their peer credentials on unix domain sockets. The peer of calls
+// forwarded by a gateway is the gateway, so their peer credentials are ignored.
+func (policy *apiAuthPolicy) identify(ctx context.Context, internalToken string) (string, string, error) {
+	if token, ok := bearerToken(ctx); ok {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(internalToken)) == 1 {
+			return _AUTH_INTERNAL_CALLER, AUTH_ROLE_ADMIN, nil
+		}
+		for _, caller := range policy.Callers {
+			if caller.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(caller.Token)) == 1 {
+				return caller.Name, caller.Role, nil
+			}
+		}
+		return "", "", status.Errorf(codes.Unauthenticated, "unknown bearer token")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	_, forwarded := gatewayClient(md)
+	if p, ok := peer.FromContext(ctx); ok && !forwarded {
+		if creds, ok := p.Addr.(*peerCredAddr); ok {
+			for _, caller := range policy.Callers {
+				if caller.Uid != nil && *caller.Uid == creds.uid {
+					return caller.Name, caller.Role, nil
+				}
+			}
+			for _, caller := range policy.Callers {
+				if caller.Gid != nil && *caller.Gid == creds.gid {
+					return caller.Name, caller.Role, nil
+				}
+			}
+		}
+	}
+	if policy.DefaultRole != "" {
+		return _AUTH_ANONYMOUS_CALLER, policy.DefaultRole, nil
+	}
+	return "", "", status.Errorf(codes.Unauthenticated, "unidentified caller")
+}
+
+// allows returns whether the role may call the method.
+func (policy *apiAuthPolicy) allows(role, method string) bool {
+	for _, allowed := range policy.Roles[role] {
+		if allowed == method || allowed == _AUTH_ALL_METHODS {
+			return true
+		}
+	}
+	return false
+}
+
+// bearerToken returns the bearer token sent with the gRPC call of the context.
+func bearerToken(ctx context.Context) (string, bool) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(AUTHORIZATION_METADATA_KEY) {
+			if strings.HasPrefix(value, _BEARER_PREFIX) {
+				return strings.TrimPrefix(value, _BEARER_PREFIX), true
+			}
+		}
+	}
+	return "", false
+}
+
+// authorize returns a context with the authorized caller of the call to the
+// full method at the address, or an error if the policy of the service of the
+// method does not allow the caller to call it. Calls to services without a
+// policy are always allowed.
+func authorize(ctx context.Context, addr, fullMethod string, ctxt *ApiServiceContext) (context.Context, error) {
+	kind := methodServiceKind(fullMethod)
+	policy := ctxt.authPolicy(addr, kind)
+	if policy == nil {
+		return ctx, nil
+	}
+	caller, role, err := policy.identify(ctx, ctxt.internalToken)
+	if err != nil {
+		return nil, err
+	}
+	if holder, ok := ctx.Value(authCallerKey{}).(*authCallerHolder); ok {
+		holder.caller = caller
+	} else {
+		ctx = context.WithValue(ctx, authCallerKey{}, &authCallerHolder{caller: caller})
+	}
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if !policy.allows(role, method) {
+		return nil, status.Errorf(codes.PermissionDenied, "caller %s with role %s may not call %s.%s",
+			caller, role, kind, method)
+	}
+	return ctx, nil
+}
+
+// authPolicy returns the authorization policy of the service kind served at
+// the address, or nil if it has none.
+func (ctxt *ApiServiceContext) authPolicy(addr, kind string) *apiAuthPolicy {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if a, ok := ctxt.addrs[addr]; ok {
+		return a.policies[kind]
+	}
+	return nil
+}
+
+// authorizeUnaryInterceptor returns a server interceptor that rejects calls
+// not allowed by the authorization policy of their service.
+func authorizeUnaryInterceptor(addr string, ctxt *ApiServiceContext) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if ctx, err := authorize(ctx, addr, info.FullMethod, ctxt); err != nil {
+			return nil, err
+		} else {
+			return handler(ctx, req)
+		}
+	}
+}
+
+// authorizeStreamInterceptor returns a server interceptor that rejects calls
+// not allowed by the authorization policy of their service.
+func authorizeStreamInterceptor(addr string, ctxt *ApiServiceContext) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		if ctx, err := authorize(ss.Context(), addr, info.FullMethod, ctxt); err != nil {
+			return err
+		} else {
+			return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+		}
+	}
+}
+
+// authServerStream wraps a server stream to use a context with the authorized caller.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *authServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+// authTokenUnaryInterceptor returns a client interceptor that sends the bearer
+// token of the request message with each call to the address. Calls to servers
+// of the context itself send the internal token of the context instead, and
+// calls forwarded by a gateway send the token of their HTTP client along with
+// the internal token as the gateway token.
+func authTokenUnaryInterceptor(addr string, ctxt *ApiServiceContext) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+		if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get(_GATEWAY_METADATA_KEY)) > 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, _GATEWAY_TOKEN_METADATA_KEY, ctxt.internalToken)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		token := ""
+		if ctxt.isServer(addr) {
+			token = ctxt.internalToken
+		} else if msg, ok := req.(ApiServiceMessage); ok && msg.GetApiAuthTokenFile() != "" {
+			if data, err := os.ReadFile(msg.GetApiAuthTokenFile()); err != nil {
+				return err
+			} else {
+				token = strings.TrimSpace(string(data))
+			}
+		}
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, AUTHORIZATION_METADATA_KEY, _BEARER_PREFIX+token)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// isServer returns whether the context serves at the address.
+func (ctxt *ApiServiceContext) isServer(addr string) bool {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	a, ok := ctxt.addrs[addr]
+	return ok && a.grpcServer != nil
+}
+
+// newInternalToken returns a new random token that identifies the clients of a
+// context to its own servers.
+func newInternalToken() string {
+	data := make([]byte, _INTERNAL_TOKEN_BYTES)
+	if _, err := rand.Read(data); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(data)
+}
+
+// peerCredListener wraps a unix domain socket listener so that the remote
+// address of each accepted connection carries the credentials of its peer.
+type peerCredListener struct {
+	net.Listener
+}
+
+func (l *peerCredListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if uid, gid, err := unixPeerCreds(conn); err == nil {
+		return &peerCredConn{Conn: conn, addr: &peerCredAddr{uid: uid, gid: gid}}, nil
+	}
+	return conn, nil
+}
+
+// peerCredConn is a connection whose remote address carries peer credentials.
+type peerCredConn struct {
+	net.Conn
+	addr *peerCredAddr
+}
+
+func (c *peerCredConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// peerCredAddr is the address of the peer process of a unix domain socket
+// connection, identified by its user and group id.
+type peerCredAddr struct {
+	uid, gid uint32
+}
+
+func (a *peerCredAddr) Network() string {
+	return "unix"
+}
+
+func (a *peerCredAddr) String() string {
+	return fmt.Sprintf("uid=%d,gid=%d", a.uid, a.gid)
+}
@@ -0,0 +1,258 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata key of the api version of the request messages of each gRPC call,
+// which servers of other versions convert the requests and responses from.
+const API_VERSION_METADATA_KEY = "x-alt-api-version"
+
+// KindConverter converts a message of one version of a kind to the adjacent
+// newer or older version.
+type KindConverter func(msg proto.Message) (proto.Message, error)
+
+// Registered converters by kind, from version, and to version. Converters
+// registered with RegisterConverter take precedence over generated defaults.
+var kindConverters = struct {
+	sync.RWMutex
+	custom   map[string]KindConverter
+	defaults map[string]KindConverter
+}{
+	custom:   make(map[string]KindConverter),
+	defaults: make(map[string]KindConverter),
+}
+
+// RegisterConverter registers a function to convert messages of the kind from
+// one version to the next or previous version, replacing the generated default
+// conversion. Kinds whose fields changed between versions have no default and
+// cannot be converted without a registered converter.
+func RegisterConverter(kind, fromVersion, toVersion string, converter KindConverter) {
+	kindConverters.Lock()
+	defer kindConverters.Unlock()
+	kindConverters.custom[converterKey(kind, fromVersion, toVersion)] = converter
+}
+
+// registerDefaultConverter registers the generated conversion of messages of
+// the kind from one version to an adjacent version. Defaults are generated only
+// for kinds whose fields of the older version are unchanged in the newer one,
+// so the conversion copies all fields by wire format.
+func registerDefaultConverter(kind, fromVersion, toVersion string, newMsg func() proto.Message) {
+	kindConverters.Lock()
+	defer kindConverters.Unlock()
+	kindConverters.defaults[converterKey(kind, fromVersion, toVersion)] = func(msg proto.Message) (proto.Message, error) {
+		toMsg := newMsg()
+		if data, err := proto.Marshal(msg); err != nil {
+			return nil, err
+		} else if err := proto.Unmarshal(data, toMsg); err != nil {
+			return nil, err
+		}
+		return toMsg, nil
+	}
+}
+
+// KindVersions returns the known versions of the kind, oldest first.
+func KindVersions(kind string) []string {
+	return kindVersions[kind]
+}
+
+// ServedKindVersion returns the latest version of the kind served by the
+// services in the map of service kind/version keys to implementations, and
+// whether any service of the kind's package is in the map.
+func ServedKindVersion(kind string, kindImplMap map[string]interface{}) (string, bool) {
+	pkgName := kind[:strings.LastIndex(kind, ".")+1]
+	versions := KindVersions(kind)
+	servedVersion, servedIndex := "", -1
+	for serviceKindVer := range kindImplMap {
+		serviceKind, version := splitKindVersion(serviceKindVer)
+		if !strings.HasPrefix(serviceKind, pkgName) || strings.Contains(serviceKind[len(pkgName):], ".") {
+			continue
+		}
+		if index := indexOf(versions, version); servedIndex < 0 || index > servedIndex {
+			servedVersion, servedIndex = version, index
+		}
+	}
+	return servedVersion, servedVersion != ""
+}
+
+// ConvertApiProtoMessage converts the message to the specified version of its
+// kind by applying the converter of each intermediate version in turn. Servers
+// upconvert requests of older versions and downconvert their responses.
+func ConvertApiProtoMessage(msg *ApiProtoMessage, toVersion string) (*ApiProtoMessage, error) {
+	if msg.Version == toVersion {
+		return msg, nil
+	}
+	versions := KindVersions(msg.Kind)
+	fromIndex, toIndex := indexOf(versions, msg.Version), indexOf(versions, toVersion)
+	if fromIndex < 0 {
+		return nil, errors.New("unrecognized message kind/version: " + msg.Kind + "/" + msg.Version)
+	} else if toIndex < 0 {
+		return nil, errors.New("unrecognized message kind/version: " + msg.Kind + "/" + toVersion)
+	}
+	step := 1
+	if fromIndex > toIndex {
+		step = -1
+	}
+	def := msg.Def
+	for i := fromIndex; i != toIndex; i += step {
+		key := converterKey(msg.Kind, versions[i], versions[i+step])
+		kindConverters.RLock()
+		converter, ok := kindConverters.custom[key]
+		if !ok {
+			converter, ok = kindConverters.defaults[key]
+		}
+		kindConverters.RUnlock()
+		if !ok {
+			return nil, errors.New("no converter for " + key)
+		}
+		var err error
+		if def, err = converter(def); err != nil {
+			return nil, err
+		} else if def == nil {
+			return nil, errors.New("converter returned nil for " + key)
+		}
+	}
+	if kind, version := messageKindVersion(def); kind != msg.Kind || version != toVersion {
+		return nil, errors.New("converter returned " + kind + "/" + version + " for " + msg.Kind + "/" + toVersion)
+	}
+	converted := *msg
+	converted.Version = toVersion
+	converted.Def = def
+	return &converted, nil
+}
+
+// convertingMethodHandler returns the gRPC handler of a unary method of a served
+// version of a service. It decodes each request as the version the client sent
+// in metadata, upconverts it for the impl and the interceptors, and downconverts
+// the response to that version.
+func convertingMethodHandler(fullMethod, inputKind, servedVersion string,
+	call func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error)) func(
+	interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error,
+		interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+
+		version := servedVersion
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(API_VERSION_METADATA_KEY); len(values) > 0 {
+				version = values[0]
+			}
+		}
+		in, err := unmarshalKind(inputKind, version, nil)
+		if err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		req, err := ConvertApiProtoMessage(&ApiProtoMessage{Kind: inputKind, Version: version, Def: in}, servedVersion)
+		if err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return call(srv, ctx, req.(proto.Message))
+		}
+		var out interface{}
+		if interceptor == nil {
+			out, err = handler(ctx, req.Def)
+		} else {
+			out, err = interceptor(ctx, req.Def, &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}, handler)
+		}
+		if err != nil || version == servedVersion {
+			return out, err
+		}
+		outMsg, ok := out.(proto.Message)
+		if !ok {
+			return out, nil
+		}
+		outKind, outVersion := messageKindVersion(outMsg)
+		if len(KindVersions(outKind)) == 0 {
+			return out, nil // Not an api message, e.g. google.protobuf.Empty.
+		}
+		resp, err := ConvertApiProtoMessage(&ApiProtoMessage{Kind: outKind, Version: outVersion, Def: outMsg}, version)
+		if err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		return resp.Def, nil
+	}
+}
+
+// VersionUnaryClientInterceptor returns a client interceptor that sends the api
+// version of the request message of each call.
+func VersionUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+		if msg, ok := req.(proto.Message); ok {
+			if kind, version := messageKindVersion(msg); len(KindVersions(kind)) > 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, API_VERSION_METADATA_KEY, version)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// messageKindVersion returns the kind and version of an api message, whose
+// version is the name of the version directory of its go package.
+func messageKindVersion(msg proto.Message) (string, string) {
+	msgType := reflect.TypeOf(msg)
+	if msgType.Kind() == reflect.Ptr {
+		msgType = msgType.Elem()
+	}
+	return proto.MessageName(msg), path.Base(msgType.PkgPath())
+}
+
+// latestVersion returns the latest of the versions, comparing the numbers of
+// versions of the form v<number> numerically.
+func latestVersion(versions []string) string {
+	latest := ""
+	for _, version := range versions {
+		if latest == "" || versionNumber(latest) < versionNumber(version) {
+			latest = version
+		}
+	}
+	return latest
+}
+
+// versionNumber returns the number of a version of the form v<number>, or -1.
+func versionNumber(version string) int {
+	if n, err := strconv.Atoi(strings.TrimPrefix(version, "v")); err == nil {
+		return n
+	}
+	return -1
+}
+
+// converterKey returns the key of the converter of a kind between versions.
+func converterKey(kind, fromVersion, toVersion string) string {
+	return kind + "/" + fromVersion + "->" + toVersion
+}
+
+// splitKindVersion splits a kind/version key into the kind and version.
+func splitKindVersion(kindVersion string) (string, string) {
+	if i := strings.LastIndex(kindVersion, "/"); i >= 0 {
+		return kindVersion[:i], kindVersion[i+1:]
+	}
+	return kindVersion, ""
+}
+
+// indexOf returns the index of the value in the slice, or -1 if not found.
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
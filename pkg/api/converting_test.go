@@ -0,0 +1,156 @@
+package api
+
+import (
+	api_os_machine_runtime_v0 "alt-os/api/os/machine/runtime/v0"
+	api_testdata_v1 "alt-os/api/testdata/v1"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Kind of the requests converted by the converting tests.
+const _TEST_CONVERT_KIND = "os.machine.runtime.KillRequest"
+
+// withTestConverters registers converters of the test kind between v0 and the
+// test-only v1 for the duration of the test.
+func withTestConverters(t *testing.T) {
+	t.Helper()
+	versions := kindVersions[_TEST_CONVERT_KIND]
+	kindVersions[_TEST_CONVERT_KIND] = []string{"v0", "v1"}
+	RegisterConverter(_TEST_CONVERT_KIND, "v0", "v1", func(msg proto.Message) (proto.Message, error) {
+		in := msg.(*api_os_machine_runtime_v0.KillRequest)
+		return &api_testdata_v1.KillRequest{ApiHostname: in.ApiHostname, ApiPort: in.ApiPort, Name: in.Id,
+			Signal: int32(in.Signal)}, nil
+	})
+	RegisterConverter(_TEST_CONVERT_KIND, "v1", "v0", func(msg proto.Message) (proto.Message, error) {
+		in := msg.(*api_testdata_v1.KillRequest)
+		return &api_os_machine_runtime_v0.KillRequest{ApiHostname: in.ApiHostname, ApiPort: in.ApiPort, Id: in.Name,
+			Signal: api_os_machine_runtime_v0.KillSignal(in.Signal)}, nil
+	})
+	t.Cleanup(func() {
+		kindVersions[_TEST_CONVERT_KIND] = versions
+		kindConverters.Lock()
+		defer kindConverters.Unlock()
+		delete(kindConverters.custom, converterKey(_TEST_CONVERT_KIND, "v0", "v1"))
+		delete(kindConverters.custom, converterKey(_TEST_CONVERT_KIND, "v1", "v0"))
+	})
+}
+
+func TestConvertApiProtoMessage(t *testing.T) {
+	withTestConverters(t)
+	v0 := &api_os_machine_runtime_v0.KillRequest{ApiPort: 8889, Id: "vm0",
+		Signal: api_os_machine_runtime_v0.KillSignal_SIGKILL}
+	v1 := &api_testdata_v1.KillRequest{ApiPort: 8889, Name: "vm0",
+		Signal: int32(api_os_machine_runtime_v0.KillSignal_SIGKILL)}
+
+	up, err := ConvertApiProtoMessage(&ApiProtoMessage{Kind: _TEST_CONVERT_KIND, Version: "v0", Def: v0, Id: "msg0"}, "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if up.Version != "v1" || up.Id != "msg0" || !proto.Equal(up.Def, v1) {
+		t.Errorf("converted to %s id %q %v, expected v1 id %q %v", up.Version, up.Id, up.Def, "msg0", v1)
+	}
+	down, err := ConvertApiProtoMessage(up, "v0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if down.Version != "v0" || !proto.Equal(down.Def, v0) {
+		t.Errorf("converted to %s %v, expected v0 %v", down.Version, down.Def, v0)
+	}
+
+	// Unknown versions are reported by the version that is unknown.
+	for _, msg := range []*ApiProtoMessage{
+		{Kind: _TEST_CONVERT_KIND, Version: "v2", Def: v0},
+		{Kind: _TEST_CONVERT_KIND, Version: "v0", Def: v0},
+	} {
+		toVersion := "v2"
+		if msg.Version == "v2" {
+			toVersion = "v1"
+		}
+		_, err := ConvertApiProtoMessage(msg, toVersion)
+		if err == nil || !strings.HasSuffix(err.Error(), _TEST_CONVERT_KIND+"/v2") {
+			t.Errorf("converting %s/%s to %s: error %v, expected unrecognized %s/v2", msg.Kind, msg.Version,
+				toVersion, err, msg.Kind)
+		}
+	}
+
+	// Versions without a converter between them are not converted.
+	kindConverters.Lock()
+	converter := kindConverters.custom[converterKey(_TEST_CONVERT_KIND, "v0", "v1")]
+	delete(kindConverters.custom, converterKey(_TEST_CONVERT_KIND, "v0", "v1"))
+	kindConverters.Unlock()
+	if _, err := ConvertApiProtoMessage(&ApiProtoMessage{Kind: _TEST_CONVERT_KIND, Version: "v0", Def: v0},
+		"v1"); err == nil || !strings.HasPrefix(err.Error(), "no converter") {
+		t.Errorf("converting without a converter: error %v, expected no converter", err)
+	}
+	RegisterConverter(_TEST_CONVERT_KIND, "v0", "v1", converter)
+}
+
+func TestConvertingMethodHandler(t *testing.T) {
+	withTestConverters(t)
+	var called proto.Message
+	handler := convertingMethodHandler("/os.machine.runtime.VmRuntimeService/Kill", _TEST_CONVERT_KIND, "v1",
+		func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+			called = req
+			return &types.Empty{}, nil
+		})
+
+	// A v0 client calls the v1 server, through the version interceptor.
+	v0 := &api_os_machine_runtime_v0.KillRequest{Id: "vm0", Signal: api_os_machine_runtime_v0.KillSignal_SIGTERM}
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	if err := VersionUnaryClientInterceptor()(context.Background(), "/os.machine.runtime.VmRuntimeService/Kill",
+		v0, &types.Empty{}, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+	if values := md.Get(API_VERSION_METADATA_KEY); len(values) != 1 || values[0] != "v0" {
+		t.Fatalf("sent %s %v, expected v0", API_VERSION_METADATA_KEY, values)
+	}
+	data, err := proto.Marshal(v0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec := func(in interface{}) error { return proto.Unmarshal(data, in.(proto.Message)) }
+
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	out, err := handler(nil, ctx, dec, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := out.(*types.Empty); !ok {
+		t.Errorf("returned %v, expected empty", out)
+	}
+	expected := &api_testdata_v1.KillRequest{Name: "vm0", Signal: int32(api_os_machine_runtime_v0.KillSignal_SIGTERM)}
+	if !proto.Equal(called, expected) {
+		t.Errorf("called with %v, expected %v", called, expected)
+	}
+
+	// Interceptors see the converted request.
+	var intercepted interface{}
+	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		intercepted = req
+		return handler(ctx, req)
+	}
+	if _, err := handler(nil, ctx, dec, interceptor); err != nil {
+		t.Fatal(err)
+	}
+	if msg, ok := intercepted.(proto.Message); !ok || !proto.Equal(msg, expected) {
+		t.Errorf("intercepted %v, expected %v", intercepted, expected)
+	}
+
+	// Requests of unknown versions are refused.
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(API_VERSION_METADATA_KEY, "v2"))
+	if _, err := handler(nil, ctx, dec, nil); err == nil {
+		t.Error("called with unknown version v2")
+	}
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Server name to verify the certificate of API servers on unix domain sockets against.
+const _UNIX_TLS_SERVER_NAME = "localhost"
+
+// apiServerTlsMessage interface represents service messages that can
+// specify TLS settings for a created API server.
+type apiServerTlsMessage interface {
+	GetApiServerCertFile() string
+	GetApiServerKeyFile() string
+	GetApiClientCaFile() string
+}
+
+// serverCredsOptions returns the server options to configure transport
+// credentials for an API server created by the message. TLS is used if the
+// message specifies a server certificate, and clients must present a certificate
+// signed by the client CA if one is specified.
+func serverCredsOptions(msg ApiServiceMessage) ([]grpc.ServerOption, error) {
+	if tlsConfig, err := serverTlsConfig(msg); err != nil || tlsConfig == nil {
+		return nil, err
+	} else {
+		return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+	}
+}
+
+// serverTlsConfig returns the TLS configuration of an API server created by the
+// message, or nil if the server does not use TLS.
+func serverTlsConfig(msg ApiServiceMessage) (*tls.Config, error) {
+	tlsMsg, ok := msg.(apiServerTlsMessage)
+	if !ok || (tlsMsg.GetApiServerCertFile() == "" && tlsMsg.GetApiServerKeyFile() == "" &&
+		tlsMsg.GetApiClientCaFile() == "") {
+		return nil, nil
+	}
+	if tlsMsg.GetApiServerCertFile() == "" || tlsMsg.GetApiServerKeyFile() == "" {
+		return nil, errors.New("server TLS requires both certificate and key")
+	}
+	cert, err := tls.LoadX509KeyPair(tlsMsg.GetApiServerCertFile(), tlsMsg.GetApiServerKeyFile())
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if tlsMsg.GetApiClientCaFile() != "" {
+		if pool, err := loadCertPool(tlsMsg.GetApiClientCaFile()); err != nil {
+			return nil, err
+		} else {
+			tlsConfig.ClientCAs = pool
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return tlsConfig, nil
+}
+
+// clientCreds returns the transport credentials for a client connecting to
+// the specified context address with the TLS settings of the message.
+func clientCreds(addr string, msg ApiServiceMessage) (credentials.TransportCredentials, error) {
+	return ClientCreds(addr, msg.GetApiTlsCaFile(), msg.GetApiTlsCertFile(), msg.GetApiTlsKeyFile())
+}
+
+// ClientCreds returns the transport credentials for a client connecting to the
+// specified context address. TLS is used if a CA or client certificate is
+// specified, otherwise the connection is insecure.
+func ClientCreds(addr, caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	if caFile == "" && certFile == "" && keyFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if strings.HasPrefix(addr, _UNIX_ADDR_PREFIX) {
+		tlsConfig.ServerName = _UNIX_TLS_SERVER_NAME
+	}
+	if caFile != "" {
+		if pool, err := loadCertPool(caFile); err != nil {
+			return nil, err
+		} else {
+			tlsConfig.RootCAs = pool
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, errors.New("client TLS requires both certificate and key")
+		}
+		if cert, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+			return nil, err
+		} else {
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// loadCertPool loads a pool of CA certificates from the specified PEM file.
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pemBytes, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemBytes) {
+		return nil, errors.New("no certificates found: " + caFile)
+	}
+	return pool, nil
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Default backoff between attempts to connect to an API server and between
+// retries of API requests.
+var DefaultRetryBackoff = backoff.Config{
+	BaseDelay:  100 * time.Millisecond,
+	Multiplier: 2,
+	Jitter:     0.2,
+	MaxDelay:   5 * time.Second,
+}
+
+// Methods that do not change the state of a service.
+var readOnlyMethods = []string{"List", "QueryState"}
+
+// Minimum time to allow each attempt to connect to an API server.
+const _MIN_CONNECT_TIMEOUT = time.Second
+
+// Deadline of a call, including its retries, when the request message sets no
+// timeout, so that calls waiting for a server that never becomes ready fail.
+const _DEFAULT_CALL_TIMEOUT = 60 * time.Second
+
+// clientDialOptions returns the dial options that make clients of the address
+// reconnect with exponential backoff and wait for the server to be ready before
+// each call, rather than failing immediately while the server is starting.
+// Each call also sends the bearer token and the api version of its request
+// message.
+func clientDialOptions(addr string, ctxt *ApiServiceContext) []grpc.DialOption {
+	interceptors := append([]grpc.UnaryClientInterceptor{}, ctxt.UnaryClientInterceptors...)
+	interceptors = append(interceptors, authTokenUnaryInterceptor(addr, ctxt),
+		VersionUnaryClientInterceptor(), retryUnaryInterceptor(ctxt.RetryBackoff))
+	return []grpc.DialOption{
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           ctxt.RetryBackoff,
+			MinConnectTimeout: _MIN_CONNECT_TIMEOUT,
+		}),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+		grpc.WithChainUnaryInterceptor(interceptors...),
+		grpc.WithChainStreamInterceptor(ctxt.StreamClientInterceptors...),
+	}
+}
+
+// retryUnaryInterceptor returns a client interceptor that applies the timeout
+// of the request message to each attempt of a call, and retries attempts that
+// fail because the server is unavailable, or read-only calls that do not get a
+// response in time, as many times as the message specifies.
+func retryUnaryInterceptor(retryBackoff backoff.Config) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+		var timeout time.Duration
+		var retries uint32
+		if msg, ok := req.(ApiServiceMessage); ok {
+			timeout = time.Duration(msg.GetApiTimeout()) * time.Second
+			retries = msg.GetApiRetries()
+		}
+		for attempt := uint32(0); ; attempt++ {
+			err := invokeAttempt(ctx, timeout, method, req, reply, cc, invoker, opts...)
+			if err == nil || attempt >= retries || !isRetryable(method, err) {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(retryDelay(retryBackoff, attempt)):
+			}
+		}
+	}
+}
+
+// invokeAttempt makes a single attempt of a call with the specified timeout,
+// or without one if the timeout is zero.
+func invokeAttempt(ctx context.Context, timeout time.Duration, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// isRetryable returns whether a failed call of the method may succeed if
+// retried. Calls that time out may have changed the state of the service, so
+// only read-only calls are retried after timing out.
+func isRetryable(method string, err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return true
+	case codes.DeadlineExceeded:
+		return isReadOnlyMethod(method[strings.LastIndex(method, "/")+1:])
+	}
+	return false
+}
+
+// retryDelay returns the backoff delay before the retry following an attempt.
+func retryDelay(retryBackoff backoff.Config, attempt uint32) time.Duration {
+	delay := float64(retryBackoff.BaseDelay)
+	for i := uint32(0); i < attempt && delay < float64(retryBackoff.MaxDelay); i++ {
+		delay *= retryBackoff.Multiplier
+	}
+	if delay > float64(retryBackoff.MaxDelay) {
+		delay = float64(retryBackoff.MaxDelay)
+	}
+	delay *= 1 + retryBackoff.Jitter*(2*rand.Float64()-1)
+	return time.Duration(delay)
+}
+
+// isReadOnlyMethod returns whether the method does not change the state of its service.
+func isReadOnlyMethod(method string) bool {
+	for _, readOnly := range readOnlyMethods {
+		if method == readOnly {
+			return true
+		}
+	}
+	return false
+}
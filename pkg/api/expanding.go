@@ -0,0 +1,237 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Tag of yaml nodes that are replaced with the contents of another file.
+const _INCLUDE_TAG = "!include"
+
+// Matches ${VAR} and ${VAR:-default} variable references in definition values.
+var varRefRe = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_.]*)(:-([^}]*))?\}`)
+
+// Json names of definition fields that hold paths relative to the working
+// directory, which are relative to the directory of the file they are read from
+// when included.
+var pathFields = map[string]bool{
+	"virtualMachineFile":  true,
+	"virtualMachinesFile": true,
+	"bundlesFile":         true,
+	"hwDefFile":           true,
+	"rootDir":             true,
+	"apiServerCertFile":   true,
+	"apiServerKeyFile":    true,
+	"apiClientCaFile":     true,
+	"apiTlsCaFile":        true,
+	"apiTlsCertFile":      true,
+	"apiTlsKeyFile":       true,
+	"apiAuthTokenFile":    true,
+	"apiAuthPolicyFile":   true,
+}
+
+// Fields as for pathFields that hold such paths only in particular messages, by
+// message name and json name, e.g. the image dir of a virtual machine is
+// relative to the root dir instead.
+var messagePathFields = map[string]bool{
+	"os.machine.runtime.ApiServeRequest.imageDir": true,
+}
+
+// Values of definition variables, which take precedence over the environment.
+var definitionVars = struct {
+	sync.RWMutex
+	values map[string]string
+}{values: make(map[string]string)}
+
+// SetDefinitionVar sets the value of a variable to substitute for ${key}
+// references when reading definition files. Set variables take precedence
+// over environment variables of the same name.
+func SetDefinitionVar(key, value string) {
+	definitionVars.Lock()
+	defer definitionVars.Unlock()
+	definitionVars.values[key] = value
+}
+
+// lookupDefinitionVar returns the value of a definition variable, falling back
+// to the environment if the variable was not set.
+func lookupDefinitionVar(key string) (string, bool) {
+	definitionVars.RLock()
+	defer definitionVars.RUnlock()
+	if value, ok := definitionVars.values[key]; ok {
+		return value, true
+	}
+	return os.LookupEnv(key)
+}
+
+// docExpander expands include directives and variable references in the
+// documents of a file, and remembers which file each node was read from.
+type docExpander struct {
+	nodeFiles    map[*yaml.Node]string
+	includeStack []string
+}
+
+// readExpandedDocs reads each yaml document of the specified file and returns
+// the documents with all includes and variable references expanded, along with
+// the name of the file each node was read from.
+func readExpandedDocs(filename string) ([]*yaml.Node, map[*yaml.Node]string, error) {
+	expander := &docExpander{nodeFiles: make(map[*yaml.Node]string)}
+	docs, err := expander.expandFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	return docs, expander.nodeFiles, nil
+}
+
+// expandFile reads and expands each document of the specified file, or of
+// stdin if the file is "-".
+func (e *docExpander) expandFile(filename string) ([]*yaml.Node, error) {
+	for _, includer := range e.includeStack {
+		if includer == filename {
+			return nil, errors.New("include cycle: " + strings.Join(append(e.includeStack, filename), " -> "))
+		}
+	}
+	e.includeStack = append(e.includeStack, filename)
+	defer func() { e.includeStack = e.includeStack[:len(e.includeStack)-1] }()
+
+	var r io.Reader = os.Stdin
+	if filename != _STDIO_FILENAME {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+	var docs []*yaml.Node
+	decoder := yaml.NewDecoder(r)
+	for {
+		doc := &yaml.Node{}
+		if err := decoder.Decode(doc); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, errors.New(filename + ": " + err.Error())
+		}
+		node := doc
+		if doc.Kind == yaml.DocumentNode && len(doc.Content) == 1 {
+			node = doc.Content[0]
+		}
+		if node.Kind == yaml.ScalarNode && node.Tag == _INCLUDE_TAG {
+			// A document that is only an include is replaced by all included documents.
+			if includedDocs, err := e.expandInclude(filename, node); err != nil {
+				return nil, err
+			} else {
+				docs = append(docs, includedDocs...)
+			}
+		} else if err := e.expandNode(filename, doc); err != nil {
+			return nil, err
+		} else {
+			docs = append(docs, doc)
+		}
+	}
+	return docs, nil
+}
+
+// expandInclude returns the expanded documents of the file named by an include
+// node. Relative paths are resolved against the directory of the including file.
+func (e *docExpander) expandInclude(filename string, node *yaml.Node) ([]*yaml.Node, error) {
+	includePath, err := expandVars(node.Value)
+	if err != nil {
+		return nil, positionError(filename, node, err)
+	}
+	if includePath == "" {
+		return nil, positionError(filename, node, errors.New("empty include path"))
+	}
+	if !filepath.IsAbs(includePath) {
+		includePath = filepath.Join(filepath.Dir(filename), includePath)
+	}
+	if docs, err := e.expandFile(filepath.Clean(includePath)); err != nil {
+		return nil, positionError(filename, node, err)
+	} else {
+		return docs, nil
+	}
+}
+
+// expandNode expands the includes and variable references of a node tree in place.
+func (e *docExpander) expandNode(filename string, node *yaml.Node) error {
+	e.nodeFiles[node] = filename
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if value, err := expandVars(node.Value); err != nil {
+			return positionError(filename, node, err)
+		} else if value != node.Value {
+			node.Value = value
+			if node.Style == 0 {
+				node.Tag = "" // Resolve the type of the substituted plain value.
+			}
+		}
+	case yaml.DocumentNode, yaml.SequenceNode, yaml.MappingNode:
+		for i, child := range node.Content {
+			if child.Kind == yaml.ScalarNode && child.Tag == _INCLUDE_TAG {
+				if includedDocs, err := e.expandInclude(filename, child); err != nil {
+					return err
+				} else if len(includedDocs) != 1 {
+					return positionError(filename, child, errors.New("expected exactly 1 included document"))
+				} else if includedDoc := includedDocs[0]; includedDoc.Kind == yaml.DocumentNode &&
+					len(includedDoc.Content) == 1 {
+					node.Content[i] = includedDoc.Content[0]
+				} else {
+					node.Content[i] = includedDoc
+				}
+			} else if err := e.expandNode(filename, child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isPathField returns whether the field of the message holds a path relative to
+// the working directory.
+func isPathField(msgName, jsonName string) bool {
+	return pathFields[jsonName] || messagePathFields[msgName+"."+jsonName]
+}
+
+// rebaseIncludedPath resolves the relative path value of the node against the
+// directory of the file it was included from, if it was not read from the file
+// named by filename.
+func rebaseIncludedPath(node *yaml.Node, filename string, nodeFiles map[*yaml.Node]string) {
+	if nodeFilename, ok := nodeFiles[node]; ok && nodeFilename != filename &&
+		node.Value != "" && !filepath.IsAbs(node.Value) {
+		node.Value = filepath.Join(filepath.Dir(nodeFilename), node.Value)
+	}
+}
+
+// expandVars substitutes each ${VAR} and ${VAR:-default} reference in the value.
+// It is an error to reference a variable that is not set and has no default.
+func expandVars(value string) (string, error) {
+	var err error
+	expanded := varRefRe.ReplaceAllStringFunc(value, func(ref string) string {
+		match := varRefRe.FindStringSubmatch(ref)
+		if varValue, ok := lookupDefinitionVar(match[1]); ok && (varValue != "" || match[2] == "") {
+			return varValue
+		} else if match[2] != "" {
+			return match[3]
+		} else if err == nil {
+			err = errors.New("variable not set: " + match[1])
+		}
+		return ref
+	})
+	return expanded, err
+}
+
+// positionError prefixes an error with the file position of the node.
+func positionError(filename string, node *yaml.Node, err error) error {
+	return &SchemaError{
+		Filename: filename,
+		Line:     node.Line,
+		Column:   node.Column,
+		Message:  err.Error(),
+	}
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"gopkg.in/yaml.v3"
+)
+
+// Separator written between the documents of formatted files.
+const _FORMATTED_DOC_SEPARATOR = "\n---\n\n"
+
+// Canonical order of the fields of API message documents.
+var apiMessageFieldOrder = []string{"kind", "version", "id", "dependsOn", "def"}
+
+// FormatApiDefFile reads the specified yaml file of API message documents and
+// returns it formatted canonically. The fields of each message are ordered as
+// declared by the proto of its kind and named by their json names, while
+// comments, includes and variable references are kept. Returns false if no
+// document of the file has a kind, in which case it does not define messages.
+func FormatApiDefFile(filename string) ([]byte, bool, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+	var docs []*yaml.Node
+	isDef := false
+	decoder := yaml.NewDecoder(f)
+	for {
+		doc := &yaml.Node{}
+		if err := decoder.Decode(doc); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, true, errors.New(filename + ": " + err.Error())
+		}
+		if node := resolveNode(doc); node.Kind == yaml.MappingNode {
+			for i := 0; i < len(node.Content); i += 2 {
+				isDef = isDef || node.Content[i].Value == "kind"
+			}
+		}
+		docs = append(docs, doc)
+	}
+	if !isDef {
+		return nil, false, nil
+	}
+	var formatted [][]byte
+	for _, doc := range docs {
+		formatDocument(doc)
+		placeAnchorsFirst(doc, make(map[*yaml.Node]bool))
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc); err != nil {
+			return nil, true, err
+		}
+		encoder.Close()
+		formatted = append(formatted, bytes.TrimRight(buf.Bytes(), "\n"))
+	}
+	return append(bytes.Join(formatted, []byte(_FORMATTED_DOC_SEPARATOR)), '\n'), true, nil
+}
+
+// formatDocument orders the fields of an API message document and of the
+// definition of its kind.
+func formatDocument(doc *yaml.Node) {
+	node := resolveNode(doc)
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+	var kind, version string
+	var defNode *yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		switch keyNode := node.Content[i]; keyNode.Value {
+		case "kind":
+			kind = node.Content[i+1].Value
+		case "version":
+			version = node.Content[i+1].Value
+		case "def":
+			defNode = node.Content[i+1]
+		case "depends_on":
+			keyNode.Value = "dependsOn"
+		}
+	}
+	orderMappingNode(node, apiMessageFieldOrder)
+	if msg, err := unmarshalKind(kind, version, nil); err == nil && defNode != nil {
+		if descMsg, ok := msg.(descriptor.Message); ok {
+			formatMessageNode(defNode, descMsg)
+		}
+	}
+}
+
+// formatMessageNode orders the fields of the node holding the message type as
+// they are declared, names them by their json names, and formats their values.
+// Aliases are not followed, since the nodes they refer to are formatted where
+// they are defined.
+func formatMessageNode(node *yaml.Node, msg descriptor.Message) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+	_, msgDesc := descriptor.ForMessage(msg)
+	fields := make(map[string]*descriptor.FieldDescriptorProto)
+	var order []string
+	for _, field := range msgDesc.GetField() {
+		fields[field.GetName()] = field
+		fields[jsonFieldName(field)] = field
+		order = append(order, jsonFieldName(field))
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if field, ok := fields[node.Content[i].Value]; ok {
+			node.Content[i].Value = jsonFieldName(field)
+			formatFieldNode(node.Content[i+1], field)
+		}
+	}
+	orderMappingNode(node, order)
+}
+
+// formatFieldNode formats the messages held by the node of the field value.
+func formatFieldNode(node *yaml.Node, field *descriptor.FieldDescriptorProto) {
+	if entryDesc := mapEntryDescriptor(field); entryDesc != nil && node.Kind == yaml.MappingNode {
+		for _, entryField := range entryDesc.GetField() {
+			if entryField.GetNumber() == 2 {
+				for i := 1; i < len(node.Content); i += 2 {
+					formatValueNode(node.Content[i], entryField)
+				}
+			}
+		}
+	} else if field.IsRepeated() && node.Kind == yaml.SequenceNode {
+		for _, elemNode := range node.Content {
+			formatValueNode(elemNode, field)
+		}
+	} else {
+		formatValueNode(node, field)
+	}
+}
+
+// formatValueNode formats the node of a single value of the field if its type
+// is a message.
+func formatValueNode(node *yaml.Node, field *descriptor.FieldDescriptorProto) {
+	typeName := strings.TrimPrefix(field.GetTypeName(), ".")
+	if !field.IsMessage() || strings.HasPrefix(typeName, _WELL_KNOWN_TYPE_PREFIX) {
+		return
+	}
+	if msgType := proto.MessageType(typeName); msgType != nil {
+		if fieldMsg, ok := reflect.New(msgType.Elem()).Interface().(descriptor.Message); ok {
+			formatMessageNode(node, fieldMsg)
+		}
+	}
+}
+
+// orderMappingNode stably sorts the key/value pairs of the mapping node in the
+// order of their keys. An @type key is sorted first and unknown keys last.
+func orderMappingNode(node *yaml.Node, order []string) {
+	type pair struct {
+		key, value *yaml.Node
+		rank       int
+	}
+	pairs := make([]pair, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		rank := indexOf(order, node.Content[i].Value)
+		if rank < 0 && node.Content[i].Value != "@type" {
+			rank = len(order)
+		}
+		pairs = append(pairs, pair{node.Content[i], node.Content[i+1], rank})
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].rank < pairs[j].rank })
+	for i, p := range pairs {
+		node.Content[2*i], node.Content[2*i+1] = p.key, p.value
+	}
+}
+
+// placeAnchorsFirst moves the definition of each anchor that reordering placed
+// after an alias of it to the first alias, leaving an alias in its place, so
+// that every alias follows its anchor.
+func placeAnchorsFirst(node *yaml.Node, defined map[*yaml.Node]bool) {
+	if node.Kind == yaml.AliasNode && node.Alias != nil && !defined[node.Alias] {
+		anchored := node.Alias
+		moved := *anchored
+		moved.HeadComment, moved.LineComment, moved.FootComment = node.HeadComment, node.LineComment, node.FootComment
+		moved.Line, moved.Column = node.Line, node.Column
+		*anchored = yaml.Node{
+			Kind:        yaml.AliasNode,
+			Value:       moved.Anchor,
+			Alias:       node,
+			HeadComment: anchored.HeadComment,
+			LineComment: anchored.LineComment,
+			FootComment: anchored.FootComment,
+		}
+		*node = moved
+		defined[anchored] = true // Other aliases of the anchor now refer to it through anchored.
+	}
+	if node.Kind == yaml.AliasNode {
+		return
+	}
+	defined[node] = true
+	for _, child := range node.Content {
+		placeAnchorsFirst(child, defined)
+	}
+}
@@ -0,0 +1,425 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata key of gRPC calls forwarded by an HTTP gateway, whose value is the
+// remote address of the HTTP client.
+const _GATEWAY_METADATA_KEY = "x-alt-gateway"
+
+// Metadata key of the internal token of the context of the gateway, without
+// which the gateway metadata of a call is ignored.
+const _GATEWAY_TOKEN_METADATA_KEY = "x-alt-gateway-token"
+
+// Name of the request field that gateway routes take from the path.
+const _GATEWAY_ID_FIELD = "id"
+
+// Maximum size of the body of a gateway request.
+const _MAX_GATEWAY_BODY_BYTES = 16 * 1024 * 1024
+
+// Hostname a gateway listens on if the message sets none, rather than all
+// interfaces.
+const _DEFAULT_GATEWAY_HOSTNAME = "localhost"
+
+// Time allowed for requests in progress to complete when a gateway stops.
+const _GATEWAY_SHUTDOWN_TIMEOUT = 5 * time.Second
+
+// Full name of the empty response message of methods that return nothing.
+const _EMPTY_MESSAGE_NAME = "google.protobuf.Empty"
+
+// apiHttpGatewayMessage interface represents service messages that can start
+// an HTTP/JSON gateway to the API server they create.
+type apiHttpGatewayMessage interface {
+	GetApiHttpPort() uint32
+}
+
+// apiGateway serves HTTP/JSON routes that forward each request as a gRPC call
+// to the API server at the same address. Messages are encoded as in def files.
+type apiGateway struct {
+	httpServer *http.Server
+	listener   net.Listener
+	conn       *grpc.ClientConn
+	routes     []*gatewayRoute
+}
+
+// gatewayRoute maps requests with an HTTP method and path to a gRPC method.
+// The path is the prefix, followed by /{id} if the id is taken from the path,
+// followed by :verb if the verb is not empty.
+type gatewayRoute struct {
+	httpMethod string
+	prefix     string
+	withId     bool
+	verb       string
+	fullMethod string
+	version    string
+	inputKind  string
+	outputKind string
+}
+
+// gatewayError is the json body of gateway responses to failed requests.
+type gatewayError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// newGateway creates the HTTP/JSON gateway to the API server at the address
+// created by the message, with routes for the registered versions of the
+// service kinds, or returns nil if the message does not specify a gateway
+// port. The gateway listens at the hostname of the message, or at localhost if
+// not set, but does not serve requests until started. It serves HTTPS if the
+// API server uses TLS, and connects to the API server using the client
+// transport credentials of the message.
+func newGateway(addr string, msg ApiServiceMessage, grpcServer *grpc.Server, versions map[string]string,
+	ctxt *ApiServiceContext) (*apiGateway, error) {
+
+	gatewayMsg, ok := msg.(apiHttpGatewayMessage)
+	if !ok || gatewayMsg.GetApiHttpPort() == 0 {
+		return nil, nil
+	}
+	routes, err := gatewayRoutes(grpcServer, versions)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := serverTlsConfig(msg)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := clientCreds(addr, msg)
+	if err != nil {
+		return nil, err
+	}
+	target, targetOpts := DialTarget(addr)
+	dialOpts := append(clientDialOptions(addr, ctxt), targetOpts...)
+	conn, err := grpc.Dial(target, append(dialOpts, grpc.WithTransportCredentials(creds))...)
+	if err != nil {
+		return nil, err
+	}
+	hostname := msg.GetApiHostname()
+	if hostname == "" {
+		hostname = _DEFAULT_GATEWAY_HOSTNAME
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(hostname, strconv.Itoa(int(gatewayMsg.GetApiHttpPort()))))
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	g := &apiGateway{listener: listener, conn: conn, routes: routes}
+	g.httpServer = &http.Server{Handler: g}
+	return g, nil
+}
+
+// start serves requests of the gateway until it is stopped.
+func (g *apiGateway) start(ctxt *ApiServiceContext) {
+	ctxt.ServerWg.Add(1)
+	go func() {
+		if err := g.httpServer.Serve(g.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Println("Error serving gateway " + g.listener.Addr().String() + ": " + err.Error())
+		}
+		ctxt.ServerWg.Done()
+	}()
+}
+
+// stop stops listening, waits a limited time for requests in progress to
+// complete, and closes the connection to the API server.
+func (g *apiGateway) stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), _GATEWAY_SHUTDOWN_TIMEOUT)
+	defer cancel()
+	if err := g.httpServer.Shutdown(ctx); err != nil {
+		g.httpServer.Close()
+	}
+	g.conn.Close()
+}
+
+// ServeHTTP forwards the request to the gRPC method of its route. The body of
+// POST requests holds the json fields of the request message, and the id field
+// is taken from the path for routes that include it.
+func (g *apiGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, id, allowed := g.route(r.Method, r.URL.Path)
+	if route == nil && len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeGatewayError(w, http.StatusMethodNotAllowed, codes.Unimplemented, "method not allowed: "+r.Method)
+		return
+	} else if route == nil {
+		writeGatewayError(w, http.StatusNotFound, codes.NotFound, "no route: "+r.URL.Path)
+		return
+	}
+	def := make(map[string]interface{})
+	if r.Method == http.MethodPost {
+		decoder := json.NewDecoder(io.LimitReader(r.Body, _MAX_GATEWAY_BODY_BYTES))
+		decoder.UseNumber()
+		if err := decoder.Decode(&def); err != nil && !errors.Is(err, io.EOF) {
+			writeGatewayError(w, http.StatusBadRequest, codes.InvalidArgument, "invalid request body: "+err.Error())
+			return
+		}
+	}
+	if route.withId {
+		def[_GATEWAY_ID_FIELD] = id
+	}
+	req, err := NewApiProtoMessage(route.inputKind, route.version, def)
+	if err != nil {
+		writeGatewayError(w, http.StatusBadRequest, codes.InvalidArgument, "invalid request: "+err.Error())
+		return
+	}
+	var resp proto.Message = &types.Empty{}
+	if route.outputKind != _EMPTY_MESSAGE_NAME {
+		if resp, err = unmarshalKind(route.outputKind, route.version, nil); err != nil {
+			writeGatewayError(w, http.StatusInternalServerError, codes.Internal, err.Error())
+			return
+		}
+	}
+	md := metadata.Pairs(_GATEWAY_METADATA_KEY, r.RemoteAddr)
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		md.Append(AUTHORIZATION_METADATA_KEY, auth)
+	}
+	if err := g.conn.Invoke(metadata.NewOutgoingContext(r.Context(), md), route.fullMethod, req.Def, resp); err != nil {
+		st := status.Convert(err)
+		writeGatewayError(w, gatewayHttpStatus(st.Code()), st.Code(), st.Message())
+		return
+	}
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, resp); err != nil {
+		writeGatewayError(w, http.StatusInternalServerError, codes.Internal, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(buf.Bytes())
+}
+
+// route returns the route matching the HTTP method and path, and the id in the
+// path if the route takes it. If only the HTTP method does not match, the
+// allowed HTTP methods of the path are returned instead.
+func (g *apiGateway) route(httpMethod, path string) (*gatewayRoute, string, []string) {
+	var allowed []string
+	for _, route := range g.routes {
+		if id, ok := route.match(path); !ok {
+			continue
+		} else if route.httpMethod == httpMethod {
+			return route, id, nil
+		} else {
+			allowed = append(allowed, route.httpMethod)
+		}
+	}
+	return nil, "", allowed
+}
+
+// match returns whether the path matches the route, and its id if the route
+// takes it from the path.
+func (route *gatewayRoute) match(path string) (string, bool) {
+	if !strings.HasPrefix(path, route.prefix) {
+		return "", false
+	}
+	rest := path[len(route.prefix):]
+	if route.verb != "" {
+		if !strings.HasSuffix(rest, ":"+route.verb) {
+			return "", false
+		}
+		rest = strings.TrimSuffix(rest, ":"+route.verb)
+	}
+	if !route.withId {
+		return "", rest == ""
+	}
+	id := strings.TrimPrefix(rest, "/")
+	if id == rest || id == "" || strings.ContainsAny(id, "/:") {
+		return "", false
+	}
+	return id, true
+}
+
+// gatewayRoutes returns the routes of the methods of the service kinds, with
+// the registered version of each. The routes of a service are prefixed by its
+// version and package without the leading "os", e.g. /v0/machine/runtime.
+// List is routed from GET {prefix}, QueryState from GET {prefix}/{id}, Create
+// from POST {prefix}, and other methods from POST {prefix}/{id}:verb, or from
+// POST {prefix}:verb if their request has no id. Serving is not routed.
+func gatewayRoutes(grpcServer *grpc.Server, versions map[string]string) ([]*gatewayRoute, error) {
+	kinds := make([]string, 0, len(versions))
+	for kind := range versions {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	var routes []*gatewayRoute
+	for _, kind := range kinds {
+		info, ok := grpcServer.GetServiceInfo()[kind]
+		if !ok {
+			return nil, errors.New("service not registered: " + kind)
+		}
+		filename, _ := info.Metadata.(string)
+		fd, err := loadFileDescriptor(filename)
+		if err != nil {
+			return nil, err
+		}
+		service := fileService(fd, kind)
+		if service == nil {
+			return nil, errors.New("no descriptor for service: " + kind)
+		}
+		prefix := "/" + versions[kind]
+		if segments := strings.Split(fd.GetPackage(), "."); len(segments) > 1 {
+			prefix += "/" + strings.Join(segments[1:], "/")
+		}
+		for _, method := range service.Method {
+			if method.GetName() == "ApiServe" {
+				continue
+			}
+			route := &gatewayRoute{
+				httpMethod: http.MethodPost,
+				prefix:     prefix,
+				fullMethod: "/" + kind + "/" + method.GetName(),
+				version:    versions[kind],
+				inputKind:  strings.TrimPrefix(method.GetInputType(), "."),
+				outputKind: strings.TrimPrefix(method.GetOutputType(), "."),
+			}
+			hasId := messageHasIdField(fd, route.inputKind)
+			switch method.GetName() {
+			case "List":
+				route.httpMethod = http.MethodGet
+			case "QueryState":
+				route.httpMethod, route.withId = http.MethodGet, hasId
+			case "Create":
+			default:
+				route.withId = hasId
+				route.verb = strings.ToLower(method.GetName()[:1]) + method.GetName()[1:]
+			}
+			routes = append(routes, route)
+		}
+	}
+	return routes, nil
+}
+
+// fileService returns the descriptor of the service kind defined by the file,
+// or nil if the file does not define it.
+func fileService(fd *descriptor.FileDescriptorProto, kind string) *descriptor.ServiceDescriptorProto {
+	for _, service := range fd.Service {
+		if fd.GetPackage()+"."+service.GetName() == kind {
+			return service
+		}
+	}
+	return nil
+}
+
+// messageHasIdField returns whether the message kind defined by the file has a
+// singular string id field.
+func messageHasIdField(fd *descriptor.FileDescriptorProto, kind string) bool {
+	for _, msg := range fd.MessageType {
+		if fd.GetPackage()+"."+msg.GetName() != kind {
+			continue
+		}
+		for _, field := range msg.Field {
+			if field.GetName() == _GATEWAY_ID_FIELD && field.GetType() == descriptor.FieldDescriptorProto_TYPE_STRING &&
+				field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// gatewayHttpStatus returns the HTTP status of gateway responses to requests
+// that failed with the gRPC code.
+func gatewayHttpStatus(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499 // Client closed request.
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeGatewayError writes a gateway response to a failed request.
+func writeGatewayError(w http.ResponseWriter, httpStatus int, code codes.Code, message string) {
+	data, _ := json.Marshal(&gatewayError{Code: code.String(), Message: message})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	w.Write(append(data, '\n'))
+}
+
+// gatewayUnaryInterceptor returns a server interceptor that removes the gateway
+// metadata of calls not forwarded by a gateway of the context, identified by
+// its internal token, so that callers cannot claim to be HTTP clients.
+func gatewayUnaryInterceptor(ctxt *ApiServiceContext) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		return handler(verifyGatewayMetadata(ctx, ctxt.internalToken), req)
+	}
+}
+
+// gatewayStreamInterceptor returns a server interceptor that removes the gateway
+// metadata of streams as gatewayUnaryInterceptor does for calls.
+func gatewayStreamInterceptor(ctxt *ApiServiceContext) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		return handler(srv, &authServerStream{ServerStream: ss,
+			ctx: verifyGatewayMetadata(ss.Context(), ctxt.internalToken)})
+	}
+}
+
+// verifyGatewayMetadata returns the context with the gateway token removed from
+// its incoming metadata, and the gateway metadata too unless the gateway token
+// is the internal token.
+func verifyGatewayMetadata(ctx context.Context, internalToken string) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || (len(md.Get(_GATEWAY_METADATA_KEY)) == 0 && len(md.Get(_GATEWAY_TOKEN_METADATA_KEY)) == 0) {
+		return ctx
+	}
+	md = md.Copy()
+	if tokens := md.Get(_GATEWAY_TOKEN_METADATA_KEY); len(tokens) != 1 ||
+		subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(internalToken)) != 1 {
+		md.Delete(_GATEWAY_METADATA_KEY)
+	}
+	md.Delete(_GATEWAY_TOKEN_METADATA_KEY)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// gatewayClient returns the remote address of the HTTP client of a gRPC call
+// forwarded by a gateway, given the metadata of the call.
+func gatewayClient(md metadata.MD) (string, bool) {
+	if values := md.Get(_GATEWAY_METADATA_KEY); len(values) > 0 {
+		return values[0], true
+	}
+	return "", false
+}
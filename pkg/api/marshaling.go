@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 
 	api_api_v0 "alt-os/api/api/v0"
@@ -17,57 +18,65 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// Filename that refers to standard input or output instead of a file.
+const _STDIO_FILENAME = "-"
+
 // ApiProtoMessage represents a single versioned API message.
 type ApiProtoMessage struct {
-	Kind    string
-	Version string
-	Def     proto.Message
+	Kind      string
+	Version   string
+	Def       proto.Message
+	Id        string
+	DependsOn []string
 }
 
-// UnmarshalApiProtoMessages reads the specified file and unmarshals the protobuf API
-// messages it contains. If format is empty it is inferred from the file extension.
+// UnmarshalApiProtoMessages reads the specified file, or stdin if the file is "-",
+// and unmarshals the protobuf API messages it contains. If format is empty it is
+// inferred from the file extension, and stdin defaults to yaml. The formats are
+// yaml, json, pb (a serialized api.ApiMessageList) and textproto.
 func UnmarshalApiProtoMessages(filename, format string) ([]*ApiProtoMessage, error) {
 
 	// Determine how to read the input file.
-	if format == "" {
+	if format == "" && filename == _STDIO_FILENAME {
+		format = "yaml"
+	} else if format == "" {
 		format = strings.TrimPrefix(path.Ext(filename), ".")
 	}
-	var makeJsonReader func(filename string) (io.ReadCloser, error)
+	var readMsgList func(filename string, msgList *api_api_v0.ApiMessageList) error
 	switch format {
 	default:
 		return nil, errors.New("unrecognized format: " + format)
 	case "json":
-		makeJsonReader = func(filename string) (io.ReadCloser, error) { return os.Open(filename) }
+		readMsgList = jsonMessageListReader(openJsonChecked)
 	case "yml", "yaml":
-		makeJsonReader = openYamlAsJson
+		readMsgList = jsonMessageListReader(openYamlAsJson)
+	case "pb":
+		readMsgList = readPbMessageList
+	case "textproto":
+		readMsgList = readTextMessageList
 	}
 
 	// Read the message list structure from the file.
 	var msgList api_api_v0.ApiMessageList
-	if r, err := makeJsonReader(filename); err != nil {
+	if err := readMsgList(filename, &msgList); err != nil {
 		return nil, err
-	} else {
-		unmarshaler := &jsonpb.Unmarshaler{}
-		err := unmarshaler.Unmarshal(r, &msgList)
-		r.Close()
-		if err != nil {
-			return nil, err
-		}
 	}
 
 	// Unmarshal each individual specific kind of message.
 	var apiMessages []*ApiProtoMessage
 	for _, msg := range msgList.Messages {
-		if msg.Kind != msg.Def.TypeUrl {
+		if kindTypeUrl(msg.Kind, msg.Version) != msg.Def.TypeUrl {
 			return nil, errors.New("kind/type mismatch")
 		}
 		if protoMsg, err := unmarshalKind(msg.Kind, msg.Version, msg.Def.Value); err != nil {
 			return nil, err
 		} else {
 			apiMsg := &ApiProtoMessage{
-				Kind:    msg.Kind,
-				Version: msg.Version,
-				Def:     protoMsg,
+				Kind:      msg.Kind,
+				Version:   msg.Version,
+				Def:       protoMsg,
+				Id:        msg.Id,
+				DependsOn: msg.DependsOn,
 			}
 			apiMessages = append(apiMessages, apiMsg)
 		}
@@ -76,21 +85,43 @@ func UnmarshalApiProtoMessages(filename, format string) ([]*ApiProtoMessage, err
 	return apiMessages, nil
 }
 
+// NewApiProtoMessage returns a new message of the kind and version with the
+// definition fields set from def, which is keyed by json field name.
+func NewApiProtoMessage(kind, version string, def map[string]interface{}) (*ApiProtoMessage, error) {
+	protoMsg, err := unmarshalKind(kind, version, nil)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(def); err != nil {
+		return nil, err
+	} else if err := jsonpb.Unmarshal(bytes.NewReader(data), protoMsg); err != nil {
+		return nil, err
+	}
+	return &ApiProtoMessage{Kind: kind, Version: version, Def: protoMsg}, nil
+}
+
 // MarshalApiProtoMessages marshals the specified messages and writes them to the specified
-// output file. If format is empty it is inferred from the file extension.
+// output file, or to stdout if the file is "-". If format is empty it is inferred from the
+// file extension, and stdout defaults to yaml. The formats are as for unmarshaling.
 func MarshalApiProtoMessages(messages []*ApiProtoMessage, filename, format string) error {
 	// Determine how to write the output file.
-	if format == "" {
+	if format == "" && filename == _STDIO_FILENAME {
+		format = "yaml"
+	} else if format == "" {
 		format = strings.TrimPrefix(path.Ext(filename), ".")
 	}
-	var makeJsonWriter func(filename string) (io.WriteCloser, error)
+	var writeMsgList func(filename string, msgList *api_api_v0.ApiMessageList) error
 	switch format {
 	default:
 		return errors.New("unrecognized format: " + format)
 	case "json":
-		makeJsonWriter = func(filename string) (io.WriteCloser, error) { return os.Create(filename) }
+		writeMsgList = jsonMessageListWriter(createOutputFile)
 	case "yml", "yaml":
-		makeJsonWriter = createYamlAsJson
+		writeMsgList = jsonMessageListWriter(createYamlAsJson)
+	case "pb":
+		writeMsgList = writePbMessageList
+	case "textproto":
+		writeMsgList = writeTextMessageList
 	}
 
 	// Marshal each individual specific kind of message.
@@ -98,7 +129,7 @@ func MarshalApiProtoMessages(messages []*ApiProtoMessage, filename, format strin
 	for _, msg := range messages {
 		kind, version, bytes, err := marshalKind(msg.Def)
 		if err != nil {
-			return nil
+			return err
 		}
 		if kind != msg.Kind {
 			return errors.New("kind/type mismatch")
@@ -110,51 +141,148 @@ func MarshalApiProtoMessages(messages []*ApiProtoMessage, filename, format strin
 			Kind:    kind,
 			Version: version,
 			Def: &types.Any{
-				TypeUrl: kind,
+				TypeUrl: kindTypeUrl(kind, version),
 				Value:   bytes,
 			},
+			Id:        msg.Id,
+			DependsOn: msg.DependsOn,
 		}
 		msgList.Messages = append(msgList.Messages, apiMsg)
 	}
 
 	// Write the message list structure to the file.
-	if w, err := makeJsonWriter(filename); err != nil {
-		return err
-	} else {
-		marshaler := &jsonpb.Marshaler{}
-		err := marshaler.Marshal(w, &msgList)
-		w.Close()
-		if err != nil {
+	return writeMsgList(filename, &msgList)
+}
+
+// jsonMessageListReader returns a function that reads a message list from the
+// json bytes of the reader made for the file.
+func jsonMessageListReader(makeJsonReader func(filename string) (io.ReadCloser, error)) func(
+	string, *api_api_v0.ApiMessageList) error {
+
+	return func(filename string, msgList *api_api_v0.ApiMessageList) error {
+		if r, err := makeJsonReader(filename); err != nil {
+			return err
+		} else {
+			unmarshaler := &jsonpb.Unmarshaler{AnyResolver: kindVersionResolver{}}
+			err := unmarshaler.Unmarshal(r, msgList)
+			r.Close()
 			return err
 		}
 	}
+}
 
-	return nil
+// jsonMessageListWriter returns a function that writes a message list as json
+// bytes to the writer made for the file.
+func jsonMessageListWriter(makeJsonWriter func(filename string) (io.WriteCloser, error)) func(
+	string, *api_api_v0.ApiMessageList) error {
+
+	return func(filename string, msgList *api_api_v0.ApiMessageList) error {
+		if w, err := makeJsonWriter(filename); err != nil {
+			return err
+		} else {
+			marshaler := &jsonpb.Marshaler{AnyResolver: kindVersionResolver{}}
+			err := marshaler.Marshal(w, msgList)
+			if closeErr := w.Close(); err == nil {
+				err = closeErr
+			}
+			return err
+		}
+	}
 }
 
-// openYamlAsJson reads the specified yaml file, converts it to json, and returns a
-// reader for the json bytes.
-func openYamlAsJson(filename string) (io.ReadCloser, error) {
-	jsonMap := map[string][]map[string]interface{}{
-		"messages": {},
+// readPbMessageList reads a serialized message list from the file.
+func readPbMessageList(filename string, msgList *api_api_v0.ApiMessageList) error {
+	if data, err := readInputFile(filename); err != nil {
+		return err
+	} else {
+		return proto.Unmarshal(data, msgList)
 	}
-	if f, err := os.Open(filename); err != nil {
+}
+
+// writePbMessageList writes the serialized message list to the file.
+func writePbMessageList(filename string, msgList *api_api_v0.ApiMessageList) error {
+	if data, err := proto.Marshal(msgList); err != nil {
+		return err
+	} else {
+		return writeOutputFile(filename, data)
+	}
+}
+
+// kindVersionResolver resolves the type urls of message definitions to the
+// message type of the specific version of the kind.
+type kindVersionResolver struct{}
+
+func (kindVersionResolver) Resolve(typeUrl string) (proto.Message, error) {
+	kind, version := splitKindVersion(typeUrl)
+	return unmarshalKind(kind, version, nil)
+}
+
+// kindTypeUrl returns the type url of message definitions of the kind and version.
+func kindTypeUrl(kind, version string) string {
+	return kind + "/" + version
+}
+
+// openJsonChecked reads the specified json file, expands and checks each message
+// against the schema of its kind, and returns a reader for the json bytes.
+func openJsonChecked(filename string) (io.ReadCloser, error) {
+	docs, nodeFiles, err := readExpandedDocs(filename)
+	if err != nil {
 		return nil, err
+	} else if len(docs) != 1 {
+		return nil, errors.New("expected exactly 1 json value: " + filename)
+	}
+	var msgDocs []*yaml.Node
+	if listNode := resolveNode(docs[0]); listNode.Kind != yaml.MappingNode {
+		return nil, SchemaErrors{{Filename: filename, Line: listNode.Line, Column: listNode.Column,
+			Message: "expected mapping for api.ApiMessageList"}}
 	} else {
-		decoder := yaml.NewDecoder(f)
-		for {
-			yamlMap := make(map[string]interface{})
-			if err := decoder.Decode(&yamlMap); errors.Is(err, io.EOF) {
-				break
-			} else if err != nil {
-				return nil, err
+		for i := 0; i+1 < len(listNode.Content); i += 2 {
+			if msgsNode := resolveNode(listNode.Content[i+1]); listNode.Content[i].Value == "messages" &&
+				msgsNode.Kind == yaml.SequenceNode {
+				msgDocs = append(msgDocs, msgsNode.Content...)
 			}
-			protoType := yamlMap["kind"].(string)
-			defMap := yamlMap["def"].(map[string]interface{})
-			defMap["@type"] = protoType
-			jsonMap["messages"] = append(jsonMap["messages"], yamlMap)
 		}
-		f.Close()
+	}
+	if err := checkDocuments(filename, msgDocs, nodeFiles); err != nil {
+		return nil, err
+	}
+	return openDocsAsJson(msgDocs)
+}
+
+// openYamlAsJson reads the specified yaml file, expands and checks each document
+// against the schema of its kind, converts it to json, and returns a reader for
+// the json bytes.
+func openYamlAsJson(filename string) (io.ReadCloser, error) {
+	docs, nodeFiles, err := readExpandedDocs(filename)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDocuments(filename, docs, nodeFiles); err != nil {
+		return nil, err
+	}
+	return openDocsAsJson(docs)
+}
+
+// openDocsAsJson converts the checked message documents to a json message list
+// and returns a reader for the json bytes.
+func openDocsAsJson(docs []*yaml.Node) (io.ReadCloser, error) {
+	jsonMap := map[string][]map[string]interface{}{
+		"messages": {},
+	}
+	for _, doc := range docs {
+		yamlMap := make(map[string]interface{})
+		if err := doc.Decode(&yamlMap); err != nil {
+			return nil, err
+		}
+		defMap, ok := yamlMap["def"].(map[string]interface{})
+		if !ok {
+			defMap = make(map[string]interface{})
+			yamlMap["def"] = defMap
+		}
+		kind, _ := yamlMap["kind"].(string)
+		version, _ := yamlMap["version"].(string)
+		defMap["@type"] = kindTypeUrl(kind, version)
+		jsonMap["messages"] = append(jsonMap["messages"], yamlMap)
 	}
 	if data, err := json.Marshal(jsonMap); err != nil {
 		return nil, err
@@ -166,7 +294,7 @@ func openYamlAsJson(filename string) (io.ReadCloser, error) {
 // createYamlAsJson creates and returns a new writer that accepts json bytes, converts
 // json into yaml, and then writes yaml bytes to the output file.
 func createYamlAsJson(filename string) (io.WriteCloser, error) {
-	if f, err := os.Create(filename); err != nil {
+	if f, err := createOutputFile(filename); err != nil {
 		return nil, err
 	} else {
 		return &jsonYamlWriter{
@@ -176,9 +304,50 @@ func createYamlAsJson(filename string) (io.WriteCloser, error) {
 	}
 }
 
+// readInputFile reads the specified input file, or stdin if the file is "-".
+func readInputFile(filename string) ([]byte, error) {
+	if filename == _STDIO_FILENAME {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(filename)
+}
+
+// writeOutputFile writes the data to the specified output file, or to stdout
+// if the file is "-".
+func writeOutputFile(filename string, data []byte) error {
+	if w, err := createOutputFile(filename); err != nil {
+		return err
+	} else if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	} else {
+		return w.Close()
+	}
+}
+
+// createOutputFile creates the specified output file, or returns a writer to
+// stdout that is not closed if the file is "-".
+func createOutputFile(filename string) (io.WriteCloser, error) {
+	if filename == _STDIO_FILENAME {
+		return stdoutWriter{}, nil
+	}
+	return os.Create(filename)
+}
+
+// stdoutWriter implements an io.WriteCloser that writes to stdout without closing it.
+type stdoutWriter struct{}
+
+func (stdoutWriter) Write(p []byte) (n int, err error) {
+	return os.Stdout.Write(p)
+}
+
+func (stdoutWriter) Close() error {
+	return nil
+}
+
 // jsonYamlWriter implements an io.WriteCloser for writing json as yaml.
 type jsonYamlWriter struct {
-	File     *os.File
+	File     io.WriteCloser
 	JsonData *bytes.Buffer
 }
 
@@ -188,17 +357,23 @@ func (w *jsonYamlWriter) Write(p []byte) (n int, err error) {
 
 func (w *jsonYamlWriter) Close() (err error) {
 	type yamlMsgStruct struct {
-		Kind    string                 `yaml:"kind"`
-		Version string                 `yaml:"version"`
-		Def     map[string]interface{} `yaml:"def"`
+		Kind      string                 `yaml:"kind"`
+		Version   string                 `yaml:"version"`
+		Id        string                 `yaml:"id,omitempty"`
+		DependsOn []string               `yaml:"dependsOn,omitempty"`
+		Def       map[string]interface{} `yaml:"def"`
 	}
+	// Decode numbers as written so that integers are not written as floats.
 	jsonMap := make(map[string][]*yamlMsgStruct)
-	err = json.Unmarshal(w.JsonData.Bytes(), &jsonMap)
+	decoder := json.NewDecoder(w.JsonData)
+	decoder.UseNumber()
+	err = decoder.Decode(&jsonMap)
 	if err == nil {
 		encoder := yaml.NewEncoder(w.File)
 		encoder.SetIndent(2)
 		for _, yamlMsg := range jsonMap["messages"] {
 			delete(yamlMsg.Def, "@type")
+			yamlMsg.Def = yamlNumbers(yamlMsg.Def).(map[string]interface{})
 			err = encoder.Encode(yamlMsg)
 			if err != nil {
 				break
@@ -213,3 +388,27 @@ func (w *jsonYamlWriter) Close() (err error) {
 	}
 	return err
 }
+
+// yamlNumbers returns the decoded json value with each json number replaced by
+// an integer if it is one, or else by a float, for encoding as a yaml number.
+func yamlNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			v[key] = yamlNumbers(elem)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = yamlNumbers(elem)
+		}
+	case json.Number:
+		if n, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return n
+		} else if n, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return n
+		} else if f, err := v.Float64(); err == nil {
+			return f
+		}
+	}
+	return value
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+// Maximum depth of nested messages to fill.
+const _FILL_MAX_DEPTH = 4
+
+func TestMarshalingRoundTrip(t *testing.T) {
+	var kindVers []string
+	for kind, versions := range kindVersions {
+		for _, version := range versions {
+			kindVers = append(kindVers, kind+"/"+version)
+		}
+	}
+	sort.Strings(kindVers)
+	formats := []string{"json", "yaml", "pb", "textproto", _STDIO_FILENAME}
+	for _, kindVer := range kindVers {
+		kind, version := splitKindVersion(kindVer)
+		for _, filled := range []bool{false, true} {
+			for _, format := range formats {
+				name := kindVer + "/" + format
+				if filled {
+					name += "/filled"
+				}
+				t.Run(name, func(t *testing.T) {
+					def, err := unmarshalKind(kind, version, nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if filled {
+						fillValue(reflect.ValueOf(def).Elem(), "", 0)
+					}
+					msg := &ApiProtoMessage{Kind: kind, Version: version, Def: def, Id: "msg0",
+						DependsOn: []string{"msg1"}}
+					read := marshalAndUnmarshal(t, msg, format)
+					if len(read) != 1 {
+						t.Fatalf("read %d messages, expected 1", len(read))
+					}
+					if read[0].Kind != kind || read[0].Version != version || read[0].Id != msg.Id ||
+						!reflect.DeepEqual(read[0].DependsOn, msg.DependsOn) {
+						t.Errorf("read %s/%s id %q depends on %v, expected %s/%s id %q depends on %v",
+							read[0].Kind, read[0].Version, read[0].Id, read[0].DependsOn,
+							kind, version, msg.Id, msg.DependsOn)
+					}
+					if !proto.Equal(read[0].Def, def) {
+						t.Errorf("read def:\n%s\nexpected:\n%s", proto.MarshalTextString(read[0].Def),
+							proto.MarshalTextString(def))
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestMarshalingDefFiles(t *testing.T) {
+	for _, defFile := range []string{
+		"../../def/os/container/bundle/os/bundle-def.yml",
+		"../../def/os/machine/image/os/vm-def-aarch64.yml",
+	} {
+		msgs, err := UnmarshalApiProtoMessages(defFile, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, format := range []string{"json", "yaml"} {
+			t.Run(filepath.Base(defFile)+"/"+format, func(t *testing.T) {
+				read := marshalAndUnmarshal(t, msgs[0], format)
+				if len(read) != 1 || !proto.Equal(read[0].Def, msgs[0].Def) {
+					t.Errorf("read %v, expected %v", read, msgs[0])
+				}
+			})
+		}
+	}
+}
+
+// marshalAndUnmarshal writes the message in the format and reads it back. The
+// stdio format writes to stdout and reads from stdin, through a file.
+func marshalAndUnmarshal(t *testing.T, msg *ApiProtoMessage, format string) []*ApiProtoMessage {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "messages."+format)
+	if format == _STDIO_FILENAME {
+		filename = filepath.Join(t.TempDir(), "stdio")
+		stdout, stdin := os.Stdout, os.Stdin
+		defer func() { os.Stdout, os.Stdin = stdout, stdin }()
+		f, err := os.Create(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		os.Stdout = f
+		err = MarshalApiProtoMessages([]*ApiProtoMessage{msg}, _STDIO_FILENAME, "")
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if os.Stdin, err = os.Open(filename); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Stdin.Close()
+		filename = _STDIO_FILENAME
+	} else if err := MarshalApiProtoMessages([]*ApiProtoMessage{msg}, filename, ""); err != nil {
+		t.Fatal(err)
+	}
+	read, err := UnmarshalApiProtoMessages(filename, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return read
+}
+
+// fillValue sets the value of a message field, or of each field of a message,
+// to a value other than its default. Integers are beyond the precision of
+// float64 or the range of int64 where their type allows. The tag is the
+// protobuf struct tag of the field.
+func fillValue(v reflect.Value, tag string, depth int) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if fieldTag := v.Type().Field(i).Tag.Get("protobuf"); fieldTag != "" {
+				fillValue(v.Field(i), fieldTag, depth)
+			}
+		}
+	case reflect.Ptr:
+		if depth >= _FILL_MAX_DEPTH {
+			return
+		}
+		if v.Type() == reflect.TypeOf(&types.Any{}) {
+			snapshot, _ := unmarshalKind("os.build.ScmSnapshot", "v0", nil)
+			fillValue(reflect.ValueOf(snapshot).Elem(), "", depth+1)
+			value, _ := proto.Marshal(snapshot)
+			v.Set(reflect.ValueOf(&types.Any{TypeUrl: kindTypeUrl("os.build.ScmSnapshot", "v0"), Value: value}))
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		fillValue(v.Elem(), tag, depth+1)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte{0, 1, 0xff})
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillValue(v.Index(0), tag, depth)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.String:
+		v.SetString("value: 'quoted' \"text\"")
+	case reflect.Int32:
+		if enumName := tagEnumName(tag); enumName != "" {
+			for _, value := range proto.EnumValueMap(enumName) {
+				if int64(value) > v.Int() {
+					v.SetInt(int64(value))
+				}
+			}
+		} else {
+			v.SetInt(-150994944)
+		}
+	case reflect.Int64:
+		v.SetInt(-9007199254740993)
+	case reflect.Uint32:
+		v.SetUint(4294967295)
+	case reflect.Uint64:
+		v.SetUint(18446744073709547520)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	}
+}
+
+// tagEnumName returns the enum type name of a protobuf struct tag, or "".
+func tagEnumName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "enum=") {
+			return strings.TrimPrefix(part, "enum=")
+		}
+	}
+	return ""
+}
@@ -698,7 +698,7 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/os/build/v0/api.proto", fileDescriptor_e368bde7568fc2fd) }
 
 var fileDescriptor_e368bde7568fc2fd = []byte{
-	// 898 bytes of a gzipped FileDescriptorProto
+	// 895 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcd, 0x6e, 0x23, 0x45,
 	0x10, 0xc7, 0x33, 0xb6, 0x71, 0x66, 0x6a, 0xec, 0xac, 0xb7, 0x81, 0x64, 0x48, 0x88, 0x71, 0x0c,
 	0x08, 0x6b, 0x25, 0x62, 0x64, 0x14, 0x89, 0x0b, 0x42, 0x9b, 0x25, 0x0b, 0x2b, 0xb1, 0xc9, 0x6a,
@@ -733,7 +733,7 @@ var fileDescriptor_e368bde7568fc2fd = []byte{
 	0x5d, 0x03, 0x0f, 0xa7, 0xee, 0x59, 0x3e, 0x15, 0xe4, 0x1d, 0xf0, 0xaf, 0x59, 0x29, 0xb9, 0xc8,
 	0x23, 0xa9, 0x4a, 0x1b, 0x0c, 0x56, 0xba, 0x54, 0xa5, 0x6e, 0xe9, 0x12, 0xc8, 0xe8, 0xb7, 0xa2,
 	0x8c, 0xf2, 0x2a, 0xc3, 0x74, 0xed, 0xf0, 0x81, 0x75, 0x3c, 0xd7, 0xfa, 0x79, 0x95, 0xdd, 0x61,
-	0x79, 0x6e, 0xd9, 0xfa, 0x5d, 0x56, 0xeb, 0x9a, 0x3d, 0x82, 0x56, 0xc9, 0xae, 0x39, 0xc2, 0x1a,
+	0x79, 0x6e, 0xd9, 0xfa, 0x5d, 0x96, 0xe7, 0x86, 0x3d, 0x82, 0x56, 0xc9, 0xae, 0x39, 0xc2, 0x1a,
 	0x6b, 0x20, 0xe6, 0x2f, 0x35, 0x8d, 0x10, 0x68, 0x54, 0x15, 0x9f, 0xe0, 0x40, 0x7a, 0x21, 0x9e,
 	0xc9, 0x07, 0x50, 0x97, 0x71, 0x86, 0xd3, 0xe7, 0x8f, 0xde, 0x5c, 0x57, 0xfc, 0x32, 0xce, 0x2e,
 	0x73, 0x5a, 0xc8, 0x99, 0x50, 0xa1, 0x26, 0xc8, 0xdb, 0xe0, 0x29, 0x9e, 0x31, 0xa9, 0x68, 0x56,
@@ -748,14 +748,13 @@ var fileDescriptor_e368bde7568fc2fd = []byte{
 	0x31, 0x4d, 0x6d, 0xc1, 0x7d, 0xa3, 0x7d, 0xa5, 0x25, 0xbd, 0x25, 0x2c, 0x52, 0xb2, 0x4c, 0x28,
 	0x86, 0x1d, 0xf0, 0x42, 0x1b, 0x17, 0xa2, 0x46, 0x0e, 0x01, 0x8c, 0x37, 0xaa, 0xca, 0xd4, 0xbe,
 	0x7b, 0xcf, 0x28, 0x7a, 0x16, 0x0f, 0xc0, 0xe3, 0x32, 0xb2, 0xfb, 0xc3, 0xc5, 0xfd, 0xe1, 0x72,
-	0x79, 0x69, 0x56, 0xc7, 0x5b, 0xe0, 0x72, 0x19, 0x99, 0x0d, 0xe4, 0xa1, 0x6f, 0x9b, 0xcb, 0x27,
-	0xda, 0x7c, 0x74, 0x02, 0xee, 0x72, 0x6f, 0x91, 0x36, 0x78, 0xa7, 0x17, 0x17, 0x57, 0xd1, 0xf9,
-	0xc5, 0xf9, 0x59, 0x67, 0x8b, 0xb4, 0xc0, 0x45, 0xf3, 0xf9, 0x69, 0xd8, 0x71, 0x56, 0xd6, 0xd9,
-	0xd3, 0x67, 0x9d, 0xda, 0xe9, 0x27, 0xbf, 0xdd, 0x76, 0xb7, 0xfe, 0xbc, 0xed, 0x3a, 0x7f, 0xdd,
-	0x76, 0xb7, 0xbe, 0x5f, 0x74, 0x9d, 0x9f, 0x16, 0x5d, 0xe7, 0x97, 0x45, 0xd7, 0xf9, 0x75, 0xd1,
-	0x75, 0x7e, 0x5f, 0x74, 0x9d, 0x6f, 0x76, 0x69, 0xaa, 0x3e, 0x14, 0xf2, 0xfe, 0xa7, 0x67, 0xdc,
-	0xc4, 0x4f, 0xc7, 0xc7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x77, 0xe4, 0x91, 0x14, 0x98, 0x06,
-	0x00, 0x00,
+	0x79, 0x89, 0x36, 0x79, 0x0b, 0x5c, 0x2e, 0x23, 0xb3, 0x81, 0x3c, 0xf4, 0x6d, 0x73, 0xf9, 0x44,
+	0x9b, 0x8f, 0x4e, 0xc0, 0x5d, 0xee, 0x2d, 0xd2, 0x06, 0xef, 0xf4, 0xe2, 0xe2, 0x2a, 0x3a, 0xbf,
+	0x38, 0x3f, 0xeb, 0x6c, 0x91, 0x16, 0xb8, 0x68, 0x3e, 0x3f, 0x0d, 0x3b, 0xce, 0xca, 0x3a, 0x7b,
+	0xfa, 0xac, 0x53, 0x3b, 0xfd, 0xe4, 0xb7, 0xdb, 0xee, 0xd6, 0x9f, 0xb7, 0x5d, 0xe7, 0xaf, 0xdb,
+	0xee, 0xd6, 0xf7, 0x8b, 0xae, 0xf3, 0xd3, 0xa2, 0xeb, 0xfc, 0xb2, 0xe8, 0x3a, 0xbf, 0x2e, 0xba,
+	0xce, 0xef, 0x8b, 0xae, 0xf3, 0xcd, 0x2e, 0x4d, 0xd5, 0x87, 0x42, 0xde, 0xff, 0xf4, 0x8c, 0x9b,
+	0xf8, 0xe9, 0xf8, 0xf8, 0x9f, 0x01, 0x00, 0x77, 0xe4, 0x91, 0x14, 0x98, 0x06, 0x00, 0x00,
 }
 
 func (this *BuildConfiguration) Equal(that interface{}) bool {
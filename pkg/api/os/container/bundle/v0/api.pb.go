@@ -7,6 +7,7 @@ import (
 	v01 "alt-os/api/os/container/process/v0"
 	v0 "alt-os/api/os/container/volume/v0"
 	v02 "alt-os/api/os/machine/image/v0"
+	_ "alt-os/api/validate/v0"
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
@@ -43,7 +44,41 @@ type ApiServeRequest struct {
 	// The number of seconds to timeout the API request.
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The path to the root directory of bundles.
-	RootDir              string   `protobuf:"bytes,4,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
+	RootDir string `protobuf:"bytes,4,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
+	// The path of a unix domain socket for the API server to listen on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,5,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The file permission bits to set on the API server socket when it is
+	// created. Defaults to 0600 if not set.
+	ApiSocketMode uint32 `protobuf:"varint,6,opt,name=api_socket_mode,json=apiSocketMode,proto3" json:"api_socket_mode,omitempty"`
+	// The path of a PEM certificate for the API server to present to clients.
+	// Enables TLS for the API server if set.
+	ApiServerCertFile string `protobuf:"bytes,7,opt,name=api_server_cert_file,json=apiServerCertFile,proto3" json:"api_server_cert_file,omitempty"`
+	// The path of the PEM private key of the API server certificate.
+	ApiServerKeyFile string `protobuf:"bytes,8,opt,name=api_server_key_file,json=apiServerKeyFile,proto3" json:"api_server_key_file,omitempty"`
+	// The path of a PEM file of CA certificates to verify clients with.
+	// Clients without a certificate signed by one of these are rejected.
+	ApiClientCaFile string `protobuf:"bytes,9,opt,name=api_client_ca_file,json=apiClientCaFile,proto3" json:"api_client_ca_file,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,10,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,11,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,12,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,13,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile string `protobuf:"bytes,14,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
+	// The path of the authorization policy file of the API server, which grants
+	// each caller a role. All callers may call every method if not set.
+	ApiAuthPolicyFile string `protobuf:"bytes,15,opt,name=api_auth_policy_file,json=apiAuthPolicyFile,proto3" json:"api_auth_policy_file,omitempty"`
+	// The port of an HTTP/JSON gateway to the API server to listen on at
+	// api_hostname, or at localhost if not set. No gateway is started if zero.
+	ApiHttpPort          uint32   `protobuf:"varint,16,opt,name=api_http_port,json=apiHttpPort,proto3" json:"api_http_port,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -109,6 +144,90 @@ func (m *ApiServeRequest) GetRootDir() string {
 	return ""
 }
 
+func (m *ApiServeRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiSocketMode() uint32 {
+	if m != nil {
+		return m.ApiSocketMode
+	}
+	return 0
+}
+
+func (m *ApiServeRequest) GetApiServerCertFile() string {
+	if m != nil {
+		return m.ApiServerCertFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiServerKeyFile() string {
+	if m != nil {
+		return m.ApiServerKeyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiClientCaFile() string {
+	if m != nil {
+		return m.ApiClientCaFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ApiServeRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiAuthPolicyFile() string {
+	if m != nil {
+		return m.ApiAuthPolicyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiHttpPort() uint32 {
+	if m != nil {
+		return m.ApiHttpPort
+	}
+	return 0
+}
+
 // ApiUnserveRequest specifies a ContainerBundleService.Unserve call.
 type ApiUnserveRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -116,7 +235,24 @@ type ApiUnserveRequest struct {
 	// The port of the listening API server to operate on.
 	ApiPort uint32 `protobuf:"varint,2,opt,name=api_port,json=apiPort,proto3" json:"api_port,omitempty"`
 	// The number of seconds to timeout the API request.
-	ApiTimeout           uint32   `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,4,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,5,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,6,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,7,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,8,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,9,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -175,6 +311,48 @@ func (m *ApiUnserveRequest) GetApiTimeout() uint32 {
 	return 0
 }
 
+func (m *ApiUnserveRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ApiUnserveRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // CreateRequest specifies a ContainerBundleService.Create call.
 type CreateRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -187,10 +365,27 @@ type CreateRequest struct {
 	// Not allowed if bundles is set.
 	BundlesFile string `protobuf:"bytes,4,opt,name=bundles_file,json=bundlesFile,proto3" json:"bundles_file,omitempty"`
 	// Objects defining the bundles to create. Not allowed if bundles_file is set.
-	Bundles              []*Bundle `protobuf:"bytes,5,rep,name=bundles,proto3" json:"bundles,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Bundles []*Bundle `protobuf:"bytes,5,rep,name=bundles,proto3" json:"bundles,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,6,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,7,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,8,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,9,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,10,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,11,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateRequest) Reset()      { *m = CreateRequest{} }
@@ -260,6 +455,48 @@ func (m *CreateRequest) GetBundles() []*Bundle {
 	return nil
 }
 
+func (m *CreateRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // Bundle defines a container bundle.
 type Bundle struct {
 	// The name of the subdirectory of the bundle within the service's bundle root directory.
@@ -368,44 +605,63 @@ func init() {
 }
 
 var fileDescriptor_b3aef20909530261 = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xee, 0x25, 0x34, 0x49, 0x5f, 0x12, 0x2a, 0x8e, 0xaa, 0x32, 0xa9, 0x6a, 0x12, 0xab, 0xaa,
-	0xb2, 0x60, 0x57, 0x41, 0xec, 0xb4, 0x29, 0x15, 0x45, 0x2a, 0xaa, 0x0c, 0x74, 0x60, 0xb1, 0x2e,
-	0xe9, 0xd5, 0x3d, 0x61, 0xfb, 0x8e, 0xf3, 0x39, 0x12, 0x1b, 0x3b, 0x7f, 0x84, 0x9f, 0xd2, 0x05,
-	0x89, 0x91, 0x91, 0xe4, 0x17, 0x30, 0x32, 0x30, 0x20, 0xdf, 0x39, 0xa9, 0x5c, 0xa5, 0x1d, 0x3b,
-	0x25, 0xef, 0x7b, 0xdf, 0x7d, 0xf7, 0xde, 0xbb, 0xef, 0x19, 0xfa, 0xe2, 0x53, 0xe8, 0x11, 0xc1,
-	0x3c, 0x9e, 0x7a, 0x63, 0x9e, 0x28, 0xc2, 0x12, 0x2a, 0xbd, 0x51, 0x96, 0x9c, 0x47, 0xd4, 0x9b,
-	0xec, 0xe5, 0x29, 0x57, 0x48, 0xae, 0x38, 0x7e, 0xcc, 0x53, 0x77, 0xc1, 0x70, 0x0d, 0xa3, 0xb3,
-	0x11, 0xf2, 0x90, 0xeb, 0xbc, 0x97, 0xff, 0x33, 0xd4, 0xce, 0x56, 0xc8, 0x79, 0x18, 0x51, 0x4f,
-	0x47, 0xa3, 0xec, 0xc2, 0xa3, 0xb1, 0x50, 0x5f, 0x8a, 0x64, 0xb7, 0x74, 0xd3, 0x84, 0x47, 0x59,
-	0x5c, 0xbe, 0xa9, 0xd3, 0x2b, 0x31, 0x84, 0xe4, 0x63, 0x9a, 0xa6, 0x65, 0xca, 0x36, 0x4f, 0xbd,
-	0x98, 0x8c, 0x2f, 0x59, 0x42, 0x3d, 0x16, 0x93, 0xb0, 0xac, 0xe0, 0x7c, 0x43, 0xb0, 0xbe, 0x2f,
-	0xd8, 0x3b, 0x2a, 0x27, 0xd4, 0xa7, 0x9f, 0x33, 0x9a, 0x2a, 0xdc, 0x83, 0x16, 0x11, 0x2c, 0xb8,
-	0xe4, 0xa9, 0x4a, 0x48, 0x4c, 0x2d, 0xd4, 0x45, 0xfd, 0x35, 0xbf, 0x49, 0x04, 0x7b, 0x5d, 0x40,
-	0xf8, 0x09, 0x34, 0x72, 0x8a, 0xe0, 0x52, 0x59, 0x95, 0x2e, 0xea, 0xb7, 0xfd, 0x3a, 0x11, 0xec,
-	0x94, 0x4b, 0x85, 0x9f, 0x42, 0xce, 0x0c, 0x14, 0x8b, 0x29, 0xcf, 0x94, 0x55, 0xd5, 0x59, 0x20,
-	0x82, 0xbd, 0x37, 0x48, 0x7e, 0x56, 0x72, 0xae, 0x82, 0x73, 0x26, 0xad, 0x07, 0x5a, 0xba, 0x9e,
-	0xc7, 0x87, 0x4c, 0x3a, 0x12, 0x1e, 0xed, 0x0b, 0xf6, 0x21, 0x49, 0xef, 0xaf, 0x1c, 0xe7, 0x0a,
-	0x41, 0x7b, 0x28, 0x29, 0x51, 0xf7, 0xd5, 0x7f, 0x0f, 0x5a, 0xc6, 0x13, 0x69, 0x70, 0xc1, 0x22,
-	0x5a, 0xcc, 0xa0, 0x59, 0x60, 0x47, 0x2c, 0xa2, 0xf8, 0x05, 0xd4, 0x8b, 0xd0, 0x5a, 0xed, 0x56,
-	0xfb, 0xcd, 0xc1, 0x96, 0xbb, 0xc4, 0x53, 0xee, 0x81, 0xfe, 0xf1, 0xe7, 0x5c, 0xe7, 0x47, 0x05,
-	0x6a, 0x06, 0xc3, 0xdb, 0x00, 0x06, 0xd5, 0x63, 0x36, 0x1d, 0xac, 0x19, 0xe4, 0x90, 0x49, 0xdc,
-	0x81, 0xc6, 0xa2, 0xbd, 0x8a, 0x4e, 0x2e, 0x62, 0x7c, 0x0c, 0x6d, 0xe3, 0xb5, 0x20, 0xe6, 0x59,
-	0xa2, 0x52, 0xab, 0xaa, 0x4b, 0xd8, 0x29, 0x97, 0x60, 0x28, 0xee, 0x70, 0x0e, 0x9c, 0xe9, 0xd8,
-	0x6f, 0x19, 0xfc, 0x44, 0x9f, 0xc4, 0x2f, 0xa1, 0x5e, 0x98, 0x52, 0x77, 0xd9, 0x1c, 0xec, 0x96,
-	0x45, 0x8a, 0xe4, 0xb5, 0xca, 0xa9, 0x01, 0xfc, 0xf9, 0x31, 0x7c, 0x0c, 0xeb, 0x13, 0x26, 0x55,
-	0x46, 0xa2, 0xa0, 0x70, 0xb1, 0xb5, 0xaa, 0x95, 0xba, 0xb9, 0x52, 0x01, 0xb9, 0xda, 0xd8, 0xee,
-	0x99, 0x21, 0x9e, 0x18, 0xd0, 0x7f, 0x38, 0x29, 0xc5, 0x78, 0x0f, 0x36, 0x6e, 0x48, 0x99, 0xf9,
-	0xd7, 0x74, 0xff, 0xb8, 0xcc, 0xce, 0x9f, 0x61, 0xf0, 0x0f, 0xc1, 0xe6, 0xa2, 0x34, 0x33, 0xd8,
-	0x7c, 0x51, 0xd8, 0x98, 0xe2, 0x37, 0xd0, 0x98, 0xaf, 0x0d, 0xde, 0x59, 0xfa, 0x38, 0x37, 0xb6,
-	0xaa, 0xb3, 0xe9, 0x9a, 0x5d, 0x77, 0xe7, 0xbb, 0xee, 0xbe, 0xca, 0x77, 0xdd, 0x59, 0xc1, 0x6f,
-	0x01, 0xae, 0x5d, 0x8f, 0x77, 0x6f, 0x53, 0x2b, 0xaf, 0xc5, 0x1d, 0x7a, 0x47, 0x50, 0x33, 0x86,
-	0xc6, 0xce, 0x52, 0xad, 0x92, 0xdb, 0x6f, 0xd7, 0x39, 0x18, 0xfe, 0x9a, 0xda, 0x2b, 0x7f, 0xa6,
-	0x36, 0xfa, 0x3b, 0xb5, 0x57, 0xbe, 0xce, 0x6c, 0xf4, 0x7d, 0x66, 0xa3, 0xab, 0x99, 0x8d, 0x7e,
-	0xce, 0x6c, 0xf4, 0x7b, 0x66, 0xa3, 0x8f, 0x3d, 0x12, 0xa9, 0x67, 0x3c, 0xbd, 0xe3, 0xb3, 0x38,
-	0xaa, 0x69, 0xd9, 0xe7, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x86, 0x59, 0xa6, 0xd6, 0x3f, 0x05,
-	0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0xa6, 0xcd, 0x8f, 0x97, 0xa6, 0x69, 0xa7, 0x65, 0x65, 0xb2, 0xda, 0x90, 0x86,
+	0xa5, 0x14, 0xa1, 0xb5, 0x51, 0xf8, 0x0b, 0x9a, 0xb0, 0x15, 0x3f, 0x54, 0x54, 0x65, 0xcb, 0x1e,
+	0x38, 0x60, 0x4d, 0x9d, 0xd9, 0x74, 0x54, 0xc7, 0x63, 0xc6, 0xe3, 0x48, 0xbd, 0x21, 0xf1, 0xcf,
+	0xf0, 0xa7, 0xec, 0x91, 0x03, 0x07, 0x0e, 0x1c, 0x68, 0x8e, 0x7b, 0x42, 0xea, 0x85, 0x03, 0x12,
+	0x68, 0xde, 0xd8, 0xee, 0x3a, 0xa4, 0x2b, 0x9f, 0x7a, 0x8a, 0xfd, 0xde, 0x67, 0xbe, 0x7e, 0x33,
+	0xdf, 0xc9, 0x9b, 0x81, 0xa3, 0xe8, 0x6a, 0xea, 0xd2, 0x88, 0xbb, 0x22, 0x76, 0x7d, 0x11, 0x2a,
+	0xca, 0x43, 0x26, 0xdd, 0x8b, 0x24, 0x9c, 0x04, 0xcc, 0x9d, 0x7f, 0xa6, 0x53, 0x4e, 0x24, 0x85,
+	0x12, 0x64, 0x4f, 0xc4, 0x4e, 0x4e, 0x38, 0x86, 0xe8, 0xec, 0x4f, 0xc5, 0x54, 0x60, 0xde, 0xd5,
+	0x4f, 0x06, 0xed, 0x3c, 0x9e, 0x0a, 0x31, 0x0d, 0x98, 0x8b, 0x6f, 0x17, 0xc9, 0x2b, 0x97, 0xcd,
+	0x22, 0x75, 0x9d, 0x26, 0x7b, 0x85, 0x2f, 0xcd, 0x45, 0x90, 0xcc, 0x8a, 0x5f, 0xea, 0x1c, 0x14,
+	0x88, 0x48, 0x0a, 0x9f, 0xc5, 0x71, 0x11, 0x79, 0x22, 0x62, 0x77, 0x46, 0xfd, 0x4b, 0x1e, 0x32,
+	0x97, 0xcf, 0xe8, 0x74, 0x49, 0xe1, 0xbd, 0x39, 0x0d, 0xf8, 0x84, 0xaa, 0x62, 0xb8, 0xff, 0xef,
+	0x06, 0xb4, 0x8f, 0x23, 0xfe, 0x82, 0xc9, 0x39, 0x1b, 0xb3, 0x1f, 0x13, 0x16, 0x2b, 0x72, 0x00,
+	0x5b, 0x34, 0xe2, 0xde, 0xa5, 0x88, 0x55, 0x48, 0x67, 0xcc, 0xb6, 0x7a, 0xd6, 0x51, 0x63, 0xdc,
+	0xa4, 0x11, 0xff, 0x32, 0x0d, 0x91, 0xf7, 0xa1, 0xae, 0x91, 0x48, 0x48, 0x65, 0xaf, 0xf7, 0xac,
+	0xa3, 0xd6, 0xb8, 0x46, 0x23, 0x7e, 0x26, 0xa4, 0x22, 0x1f, 0x80, 0x26, 0x3d, 0xc5, 0x67, 0x4c,
+	0x24, 0xca, 0xae, 0x60, 0x16, 0x68, 0xc4, 0xcf, 0x4d, 0x44, 0x8f, 0x95, 0x42, 0x28, 0x6f, 0xc2,
+	0xa5, 0xbd, 0x81, 0xd2, 0x35, 0xfd, 0xfe, 0x05, 0x97, 0xe4, 0x09, 0x68, 0xd0, 0x8b, 0x85, 0x7f,
+	0xc5, 0x94, 0xbd, 0x89, 0xc9, 0x06, 0x8d, 0xf8, 0x0b, 0x0c, 0x90, 0x43, 0x68, 0xdf, 0xa5, 0xbd,
+	0x99, 0x98, 0x30, 0xbb, 0x8a, 0xf2, 0xad, 0x9c, 0x39, 0x15, 0x13, 0x46, 0x5c, 0xd8, 0x47, 0x4e,
+	0x4f, 0x4a, 0x7a, 0x3e, 0x93, 0xca, 0x7b, 0xc5, 0x03, 0x66, 0xd7, 0x50, 0x70, 0x97, 0xa6, 0xf3,
+	0x95, 0x23, 0x26, 0xd5, 0x09, 0x0f, 0x18, 0x79, 0x06, 0x7b, 0x6f, 0x0d, 0xb8, 0x62, 0xd7, 0x86,
+	0xaf, 0x23, 0xbf, 0x93, 0xf3, 0xdf, 0xb0, 0x6b, 0xc4, 0x3f, 0x05, 0xa2, 0x71, 0x3f, 0xe0, 0x2c,
+	0x54, 0x9e, 0x4f, 0x0d, 0xdd, 0x40, 0x5a, 0x57, 0x38, 0xc2, 0xc4, 0x88, 0x22, 0xfc, 0x91, 0x29,
+	0x5a, 0x05, 0x71, 0x4e, 0x02, 0x92, 0x7a, 0x91, 0xcf, 0x83, 0x38, 0xc5, 0x3e, 0x81, 0xdd, 0x1c,
+	0xcb, 0x0b, 0x6e, 0x22, 0xb8, 0x9d, 0x82, 0x59, 0xb5, 0x1f, 0xc3, 0x4e, 0x86, 0xe6, 0xa5, 0x6e,
+	0x21, 0xd9, 0x32, 0x64, 0x56, 0x67, 0x6a, 0x85, 0x64, 0x4a, 0x72, 0x16, 0xdb, 0xad, 0xdc, 0x8a,
+	0xb1, 0x89, 0x64, 0xf3, 0xa6, 0x89, 0xba, 0xf4, 0x94, 0xb8, 0x62, 0xa1, 0x11, 0xdb, 0xce, 0xe7,
+	0x7d, 0x9c, 0xa8, 0xcb, 0x73, 0x9d, 0x40, 0xbd, 0x74, 0x5d, 0x11, 0x8f, 0x44, 0xc0, 0xfd, 0xf4,
+	0xe3, 0xed, 0x7c, 0x5d, 0x35, 0x7f, 0x86, 0x19, 0x1c, 0xd0, 0x87, 0x16, 0xee, 0x24, 0xa5, 0x22,
+	0xb3, 0x57, 0x76, 0xb0, 0x04, 0xdc, 0x4a, 0x4a, 0x45, 0x7a, 0xbf, 0xf4, 0xff, 0x58, 0x87, 0xdd,
+	0xe3, 0x88, 0x7f, 0x17, 0xc6, 0x0f, 0xb8, 0x07, 0x8b, 0x1b, 0x6d, 0x63, 0x79, 0xa3, 0xad, 0xf0,
+	0x6c, 0xb3, 0xac, 0x67, 0xd5, 0xd2, 0x9e, 0xd5, 0x4a, 0x78, 0x56, 0x2f, 0xeb, 0x59, 0x63, 0xb5,
+	0x67, 0xfd, 0xdf, 0x2a, 0xd0, 0x1a, 0x49, 0x46, 0xd5, 0x43, 0x2d, 0xed, 0x00, 0xb6, 0x4c, 0x27,
+	0x8c, 0x4d, 0x61, 0xb8, 0xb8, 0xc3, 0xf6, 0xeb, 0x5b, 0xdb, 0x7a, 0x73, 0x6b, 0xd7, 0xd2, 0xdc,
+	0xb8, 0x99, 0x3e, 0xe0, 0xa4, 0x4f, 0x20, 0x8b, 0xdb, 0x9b, 0xbd, 0xca, 0x51, 0x73, 0xf0, 0xd8,
+	0x59, 0xd1, 0x5a, 0x9d, 0x21, 0xfe, 0xfc, 0x5f, 0x2b, 0x7b, 0x58, 0xb2, 0xb5, 0x5a, 0xc2, 0xd6,
+	0x5a, 0x59, 0x5b, 0xeb, 0xa5, 0x6d, 0x6d, 0x94, 0xb0, 0x15, 0xca, 0xda, 0xda, 0xbc, 0xc7, 0xd6,
+	0x9f, 0x2b, 0x50, 0x35, 0xcb, 0x41, 0x3e, 0x04, 0x30, 0xf3, 0xc7, 0x8e, 0x8a, 0x6e, 0x0e, 0x37,
+	0xf4, 0x12, 0x8d, 0x1b, 0x26, 0xae, 0x3b, 0x6b, 0x07, 0xea, 0xb9, 0xe1, 0xeb, 0xa8, 0x99, 0xbf,
+	0x93, 0xaf, 0xa0, 0x65, 0xce, 0x1c, 0x6f, 0x26, 0x92, 0x50, 0xc5, 0x76, 0x05, 0x3d, 0x78, 0x5a,
+	0xf4, 0xc0, 0x20, 0xce, 0x28, 0x0b, 0xbc, 0xc4, 0xf7, 0xf1, 0x96, 0x89, 0x9f, 0xe2, 0x48, 0x6d,
+	0x64, 0x7a, 0x38, 0xa1, 0xef, 0xcd, 0xc1, 0x61, 0x51, 0x24, 0x4d, 0xde, 0xa9, 0x9c, 0x99, 0x40,
+	0x5a, 0x70, 0x36, 0x98, 0xfc, 0x00, 0xed, 0x39, 0x97, 0x2a, 0xa1, 0x81, 0x97, 0x9e, 0x69, 0xf8,
+	0x07, 0x6c, 0x0e, 0x7a, 0x5a, 0x2f, 0x0d, 0x39, 0x78, 0xcc, 0x39, 0x2f, 0x0d, 0x78, 0x6a, 0x82,
+	0xc3, 0xbd, 0x37, 0xb7, 0xf6, 0xf2, 0xe0, 0xf1, 0xf6, 0xbc, 0x00, 0x91, 0xe7, 0xb0, 0xbf, 0x84,
+	0xbc, 0xf5, 0xe7, 0x5d, 0x2d, 0x41, 0x8a, 0x12, 0xda, 0x85, 0xc1, 0x3f, 0x16, 0x3c, 0xca, 0xa7,
+	0x62, 0xec, 0xd0, 0x27, 0x05, 0xf7, 0x19, 0xf9, 0x1a, 0xea, 0xd9, 0xb9, 0x4a, 0x9e, 0xae, 0xdc,
+	0xcd, 0x4b, 0xc7, 0x6e, 0xe7, 0x91, 0x63, 0xee, 0x08, 0x4e, 0x76, 0x47, 0x70, 0x9e, 0xeb, 0x3b,
+	0x42, 0x7f, 0x8d, 0x7c, 0x0b, 0x70, 0xd7, 0x21, 0xc9, 0xe1, 0x7d, 0x6a, 0xc5, 0x16, 0xfa, 0x0e,
+	0xbd, 0x13, 0xa8, 0x9a, 0x96, 0x40, 0xfa, 0x2b, 0xb5, 0x0a, 0xfd, 0xe2, 0x7e, 0x9d, 0xe1, 0xe8,
+	0xf7, 0x9b, 0xee, 0xda, 0x5f, 0x37, 0x5d, 0xeb, 0xef, 0x9b, 0xee, 0xda, 0x4f, 0x8b, 0xae, 0xf5,
+	0xcb, 0xa2, 0x6b, 0xbd, 0x5e, 0x74, 0xad, 0x5f, 0x17, 0x5d, 0xeb, 0xcf, 0x45, 0xd7, 0xfa, 0xfe,
+	0x80, 0x06, 0xea, 0x99, 0x88, 0xdf, 0x71, 0x9d, 0xba, 0xa8, 0xa2, 0xec, 0xe7, 0xff, 0x0d, 0x00,
+	0x9b, 0x48, 0xa9, 0xd7, 0x77, 0x09, 0x00, 0x00,
 }
 
 func (this *ApiServeRequest) Equal(that interface{}) bool {
@@ -439,6 +695,42 @@ func (this *ApiServeRequest) Equal(that interface{}) bool {
 	if this.RootDir != that1.RootDir {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiSocketMode != that1.ApiSocketMode {
+		return false
+	}
+	if this.ApiServerCertFile != that1.ApiServerCertFile {
+		return false
+	}
+	if this.ApiServerKeyFile != that1.ApiServerKeyFile {
+		return false
+	}
+	if this.ApiClientCaFile != that1.ApiClientCaFile {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
+	if this.ApiAuthPolicyFile != that1.ApiAuthPolicyFile {
+		return false
+	}
+	if this.ApiHttpPort != that1.ApiHttpPort {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -472,6 +764,24 @@ func (this *ApiUnserveRequest) Equal(that interface{}) bool {
 	if this.ApiTimeout != that1.ApiTimeout {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -516,6 +826,24 @@ func (this *CreateRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -572,12 +900,24 @@ func (this *ApiServeRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 20)
 	s = append(s, "&v0.ApiServeRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "RootDir: "+fmt.Sprintf("%#v", this.RootDir)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiSocketMode: "+fmt.Sprintf("%#v", this.ApiSocketMode)+",\n")
+	s = append(s, "ApiServerCertFile: "+fmt.Sprintf("%#v", this.ApiServerCertFile)+",\n")
+	s = append(s, "ApiServerKeyFile: "+fmt.Sprintf("%#v", this.ApiServerKeyFile)+",\n")
+	s = append(s, "ApiClientCaFile: "+fmt.Sprintf("%#v", this.ApiClientCaFile)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
+	s = append(s, "ApiAuthPolicyFile: "+fmt.Sprintf("%#v", this.ApiAuthPolicyFile)+",\n")
+	s = append(s, "ApiHttpPort: "+fmt.Sprintf("%#v", this.ApiHttpPort)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -588,11 +928,17 @@ func (this *ApiUnserveRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 13)
 	s = append(s, "&v0.ApiUnserveRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -603,7 +949,7 @@ func (this *CreateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 15)
 	s = append(s, "&v0.CreateRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
@@ -612,6 +958,12 @@ func (this *CreateRequest) GoString() string {
 	if this.Bundles != nil {
 		s = append(s, "Bundles: "+fmt.Sprintf("%#v", this.Bundles)+",\n")
 	}
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -833,6 +1185,86 @@ func (m *ApiServeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ApiHttpPort != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiHttpPort))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ApiAuthPolicyFile) > 0 {
+		i -= len(m.ApiAuthPolicyFile)
+		copy(dAtA[i:], m.ApiAuthPolicyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthPolicyFile)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ApiClientCaFile) > 0 {
+		i -= len(m.ApiClientCaFile)
+		copy(dAtA[i:], m.ApiClientCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiClientCaFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiServerKeyFile) > 0 {
+		i -= len(m.ApiServerKeyFile)
+		copy(dAtA[i:], m.ApiServerKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiServerKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiServerCertFile) > 0 {
+		i -= len(m.ApiServerCertFile)
+		copy(dAtA[i:], m.ApiServerCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiServerCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ApiSocketMode != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiSocketMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.RootDir) > 0 {
 		i -= len(m.RootDir)
 		copy(dAtA[i:], m.RootDir)
@@ -884,6 +1316,46 @@ func (m *ApiUnserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.ApiTimeout != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.ApiTimeout))
 		i--
@@ -928,6 +1400,46 @@ func (m *CreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Bundles) > 0 {
 		for iNdEx := len(m.Bundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1086,6 +1598,51 @@ func (m *ApiServeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiSocketMode != 0 {
+		n += 1 + sovApi(uint64(m.ApiSocketMode))
+	}
+	l = len(m.ApiServerCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiServerKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiClientCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiAuthPolicyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiHttpPort != 0 {
+		n += 2 + sovApi(uint64(m.ApiHttpPort))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1108,6 +1665,29 @@ func (m *ApiUnserveRequest) Size() (n int) {
 	if m.ApiTimeout != 0 {
 		n += 1 + sovApi(uint64(m.ApiTimeout))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1140,6 +1720,29 @@ func (m *CreateRequest) Size() (n int) {
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1199,6 +1802,18 @@ func (this *ApiServeRequest) String() string {
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`RootDir:` + fmt.Sprintf("%v", this.RootDir) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiSocketMode:` + fmt.Sprintf("%v", this.ApiSocketMode) + `,`,
+		`ApiServerCertFile:` + fmt.Sprintf("%v", this.ApiServerCertFile) + `,`,
+		`ApiServerKeyFile:` + fmt.Sprintf("%v", this.ApiServerKeyFile) + `,`,
+		`ApiClientCaFile:` + fmt.Sprintf("%v", this.ApiClientCaFile) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
+		`ApiAuthPolicyFile:` + fmt.Sprintf("%v", this.ApiAuthPolicyFile) + `,`,
+		`ApiHttpPort:` + fmt.Sprintf("%v", this.ApiHttpPort) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -1212,6 +1827,12 @@ func (this *ApiUnserveRequest) String() string {
 		`ApiHostname:` + fmt.Sprintf("%v", this.ApiHostname) + `,`,
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -1232,6 +1853,12 @@ func (this *CreateRequest) String() string {
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`BundlesFile:` + fmt.Sprintf("%v", this.BundlesFile) + `,`,
 		`Bundles:` + repeatedStringForBundles + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -1397,60 +2024,9 @@ func (m *ApiServeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.RootDir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApiUnserveRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApiUnserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1478,13 +2054,13 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocketMode", wireType)
 			}
-			m.ApiPort = 0
+			m.ApiSocketMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1494,16 +2070,16 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				m.ApiSocketMode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiServerCertFile", wireType)
 			}
-			m.ApiTimeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1513,65 +2089,59 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			m.ApiServerCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiServerKeyFile", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiServerKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiClientCaFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1599,13 +2169,13 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiClientCaFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1615,16 +2185,29 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ApiTimeout = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1634,14 +2217,27 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundlesFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1669,13 +2265,32 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundlesFile = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1685,25 +2300,740 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bundles = append(m.Bundles, &Bundle{})
-			if err := m.Bundles[len(m.Bundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthPolicyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthPolicyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHttpPort", wireType)
+			}
+			m.ApiHttpPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiHttpPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiUnserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiUnserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlesFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundlesFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundles = append(m.Bundles, &Bundle{})
+			if err := m.Bundles[len(m.Bundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
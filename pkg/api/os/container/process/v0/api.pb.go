@@ -4,6 +4,7 @@
 package v0
 
 import (
+	_ "alt-os/api/validate/v0"
 	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -636,62 +637,64 @@ func init() {
 }
 
 var fileDescriptor_2bc9eca0068b2381 = []byte{
-	// 877 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6f, 0xe2, 0x46,
-	0x18, 0x8e, 0xb1, 0x49, 0xe0, 0x25, 0x90, 0xc9, 0x6c, 0xb6, 0x42, 0xa9, 0x6a, 0x21, 0xaa, 0x6a,
-	0xd9, 0x95, 0x0a, 0x55, 0x7a, 0xdb, 0xed, 0x87, 0x08, 0x71, 0x22, 0x6b, 0xc3, 0x47, 0xc7, 0x66,
-	0xab, 0xee, 0x05, 0x19, 0x3c, 0x31, 0xa3, 0x05, 0xdb, 0xb2, 0x07, 0x56, 0xb9, 0xf5, 0xe7, 0xf4,
-	0xda, 0x7f, 0xd1, 0x43, 0x0f, 0x3d, 0xee, 0xb1, 0xe1, 0xd4, 0xe3, 0x1e, 0x7b, 0xac, 0x66, 0x6c,
-	0x03, 0x51, 0x51, 0xcb, 0xed, 0x7d, 0x9f, 0x79, 0x9e, 0xc7, 0xf3, 0x7e, 0x8c, 0x0c, 0xcf, 0xc3,
-	0x77, 0x5e, 0xcb, 0x09, 0x59, 0x2b, 0x88, 0x5b, 0x93, 0xc0, 0xe7, 0x0e, 0xf3, 0x69, 0xd4, 0x0a,
-	0xa3, 0x60, 0x42, 0xe3, 0xb8, 0xb5, 0xfc, 0x4a, 0x9c, 0x35, 0xc3, 0x28, 0xe0, 0x01, 0x3e, 0x0b,
-	0xe2, 0xe6, 0x9a, 0xd2, 0x4c, 0x29, 0xe7, 0x67, 0x5e, 0xe0, 0x05, 0x92, 0xd0, 0x12, 0x51, 0xc2,
-	0x3d, 0xff, 0xd4, 0x0b, 0x02, 0x6f, 0x46, 0x5b, 0x32, 0x1b, 0x2f, 0xee, 0x5a, 0x74, 0x1e, 0xf2,
-	0xfb, 0xe4, 0xb0, 0xfe, 0x57, 0x0e, 0x50, 0x27, 0x33, 0x1a, 0x24, 0x3e, 0xf8, 0x25, 0x14, 0x38,
-	0x8d, 0xe6, 0xcc, 0x77, 0x66, 0x55, 0xa5, 0xa6, 0x34, 0x4a, 0x17, 0x7a, 0x73, 0xd7, 0x07, 0x9b,
-	0x76, 0xca, 0x22, 0x6b, 0x3e, 0x46, 0xa0, 0x4e, 0xde, 0xbb, 0xd5, 0x5c, 0x4d, 0x69, 0x14, 0x89,
-	0x08, 0xf1, 0x2b, 0x50, 0xa9, 0xbf, 0xac, 0xaa, 0x35, 0xb5, 0x51, 0xba, 0x78, 0xbe, 0xdb, 0xc8,
-	0xf0, 0x97, 0x2c, 0x0a, 0xfc, 0x39, 0xf5, 0xf9, 0x1b, 0x27, 0x62, 0xce, 0x78, 0x46, 0x89, 0x50,
-	0x61, 0x0c, 0x9a, 0x13, 0x79, 0x71, 0x55, 0xab, 0xa9, 0x8d, 0x22, 0x91, 0x31, 0xfe, 0x16, 0x8e,
-	0xa2, 0x19, 0x9b, 0x33, 0x1e, 0x57, 0xf3, 0xd2, 0xf4, 0xf3, 0xdd, 0xa6, 0x84, 0xc6, 0xc1, 0x22,
-	0x9a, 0xd0, 0x5b, 0xc1, 0x25, 0x99, 0x06, 0x5f, 0xc3, 0xf1, 0xc4, 0x09, 0x9d, 0x31, 0x9b, 0x31,
-	0xce, 0x68, 0x5c, 0x3d, 0x94, 0x15, 0xd6, 0x77, 0x7b, 0x74, 0xb6, 0x98, 0xe4, 0x91, 0x0e, 0x37,
-	0x41, 0x5b, 0xc4, 0x34, 0xaa, 0x1e, 0x49, 0xfd, 0xf9, 0x6e, 0xfd, 0x30, 0xa6, 0x11, 0x91, 0xbc,
-	0xfa, 0x00, 0x0a, 0x59, 0xbf, 0xf0, 0x27, 0x70, 0x48, 0x7d, 0x51, 0xa5, 0xec, 0x6f, 0x81, 0xa4,
-	0x99, 0xc0, 0xa7, 0x94, 0x79, 0x53, 0x2e, 0x1b, 0x58, 0x26, 0x69, 0x86, 0xcf, 0x20, 0xff, 0x9e,
-	0xb9, 0x7c, 0x5a, 0x55, 0x25, 0x9c, 0x24, 0xf5, 0xef, 0xe1, 0xc9, 0x8e, 0xc6, 0x89, 0x9e, 0xf9,
-	0xce, 0x3c, 0xb1, 0x2e, 0x12, 0x19, 0x0b, 0x83, 0xa5, 0x33, 0x5b, 0xd0, 0x74, 0x30, 0x49, 0x52,
-	0xff, 0xa0, 0x40, 0xf9, 0x51, 0x97, 0xf0, 0x2b, 0xd0, 0xf8, 0x7d, 0x98, 0x68, 0x2b, 0x17, 0xcf,
-	0xf6, 0x68, 0xac, 0x7d, 0x1f, 0x52, 0x22, 0x45, 0xf8, 0x33, 0x80, 0x38, 0xb8, 0xe3, 0xa3, 0xcd,
-	0x97, 0x34, 0x52, 0x14, 0xc8, 0x1b, 0x01, 0xe0, 0x2f, 0xa0, 0x22, 0x8f, 0x17, 0xbe, 0x9c, 0x04,
-	0x75, 0x65, 0x35, 0x05, 0x52, 0x16, 0xe8, 0x30, 0x03, 0x85, 0xcb, 0xd4, 0x89, 0xdc, 0xd4, 0x45,
-	0x4b, 0x5c, 0x04, 0xb2, 0x76, 0x91, 0xc7, 0x1b, 0x97, 0x7c, 0xe2, 0x22, 0xd0, 0xb5, 0x4b, 0xfd,
-	0xf7, 0x1c, 0x1c, 0x6f, 0x0f, 0x0f, 0x7f, 0x07, 0xc5, 0x50, 0xb4, 0x9f, 0x0b, 0x89, 0x52, 0x53,
-	0x1b, 0x95, 0x8b, 0xda, 0xff, 0xcc, 0xfc, 0x9e, 0x6c, 0x24, 0x42, 0x4f, 0xef, 0xee, 0xe8, 0x84,
-	0xb3, 0xa5, 0xa8, 0x6d, 0x4f, 0xfd, 0x5a, 0x82, 0x2f, 0xa1, 0xc4, 0xfc, 0x29, 0x8d, 0x18, 0x97,
-	0x73, 0x57, 0xf7, 0x74, 0xd8, 0x16, 0xe1, 0x6f, 0xa0, 0x30, 0x0e, 0x16, 0xbe, 0xcb, 0x7c, 0x4f,
-	0xbe, 0x88, 0x7d, 0x0c, 0xd6, 0x0a, 0xfc, 0x12, 0x8e, 0x9c, 0xf9, 0x98, 0x51, 0x9f, 0xcb, 0x77,
-	0xb3, 0x8f, 0x38, 0x13, 0xd4, 0x19, 0x68, 0x62, 0x95, 0xc5, 0xf3, 0x5e, 0x30, 0x57, 0xae, 0x47,
-	0x99, 0x88, 0x50, 0x20, 0x1e, 0x73, 0xd3, 0x7d, 0x15, 0xa1, 0xd8, 0xb5, 0xc5, 0xdc, 0x89, 0xdf,
-	0x65, 0xcb, 0x2a, 0x13, 0xfc, 0x0c, 0x4e, 0x1c, 0xd7, 0x65, 0x9c, 0x05, 0xbe, 0x33, 0x1b, 0x79,
-	0xcc, 0x4d, 0x1e, 0x75, 0x99, 0x54, 0x36, 0xf0, 0x0d, 0x73, 0xe3, 0x17, 0x1f, 0x15, 0x38, 0xfd,
-	0xd7, 0x86, 0xe1, 0x13, 0x28, 0x91, 0x5b, 0xb3, 0x6b, 0xda, 0xa3, 0x5e, 0xbf, 0x67, 0xa0, 0x03,
-	0x5c, 0x86, 0x62, 0x0a, 0xb4, 0x2d, 0xa4, 0xe0, 0x0a, 0x40, 0x9a, 0x76, 0x06, 0x43, 0x94, 0xdb,
-	0xe2, 0x5f, 0xb5, 0xed, 0x36, 0x52, 0x31, 0x82, 0xe3, 0x14, 0xb8, 0xb6, 0xcc, 0xb7, 0x06, 0xd2,
-	0x30, 0x86, 0x4a, 0x8a, 0x74, 0x8d, 0xee, 0x6d, 0xbf, 0xf3, 0x1a, 0xe5, 0xf1, 0x13, 0x38, 0xc9,
-	0x30, 0xeb, 0xe6, 0x87, 0xa1, 0x31, 0x34, 0xd0, 0x21, 0x3e, 0x85, 0xf2, 0xfa, 0xdb, 0xd7, 0xe6,
-	0xad, 0x81, 0x8e, 0xb6, 0xdc, 0x7a, 0x03, 0xd2, 0xef, 0xa0, 0xc2, 0x16, 0x89, 0xd8, 0xb6, 0xd9,
-	0x35, 0x50, 0x11, 0x3f, 0x85, 0xd3, 0x14, 0xb2, 0xcc, 0x9b, 0x81, 0xd1, 0xbb, 0x32, 0x7b, 0x37,
-	0x08, 0xb6, 0xb4, 0x96, 0xdd, 0xee, 0xbc, 0x46, 0xa5, 0x17, 0xbf, 0x2a, 0x00, 0x9b, 0xae, 0xe3,
-	0x63, 0x28, 0x74, 0xda, 0x83, 0xac, 0xd0, 0xa7, 0x70, 0x2a, 0xb2, 0x4b, 0x71, 0xc3, 0x91, 0x35,
-	0xb4, 0x84, 0x11, 0x52, 0x84, 0x8b, 0x80, 0xcd, 0x41, 0x67, 0x24, 0xef, 0x9e, 0x13, 0x37, 0x90,
-	0x32, 0xc3, 0x1e, 0xb5, 0xaf, 0xba, 0x66, 0x0f, 0xa9, 0xa2, 0x0b, 0x02, 0x1a, 0x18, 0xe4, 0xba,
-	0xdb, 0xef, 0x21, 0x2d, 0xe3, 0x58, 0x3f, 0x59, 0x29, 0x27, 0x9f, 0x19, 0x09, 0xe8, 0xb2, 0xdf,
-	0xb7, 0x93, 0x7a, 0x33, 0x84, 0xb4, 0x7f, 0x34, 0xfb, 0x49, 0xbd, 0x19, 0x24, 0x8b, 0x2b, 0x5c,
-	0x5e, 0x7d, 0x78, 0xd0, 0x0f, 0x3e, 0x3e, 0xe8, 0xca, 0xdf, 0x0f, 0xfa, 0xc1, 0xcf, 0x2b, 0x5d,
-	0xf9, 0x65, 0xa5, 0x2b, 0xbf, 0xad, 0x74, 0xe5, 0x8f, 0x95, 0xae, 0xfc, 0xb9, 0xd2, 0x95, 0xb7,
-	0x75, 0x67, 0xc6, 0xbf, 0x0c, 0xe2, 0xff, 0xfa, 0xa5, 0x8d, 0x0f, 0xe5, 0x6f, 0xe8, 0xeb, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xb8, 0xcb, 0xa0, 0xfc, 0x06, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x6f, 0xe2, 0x46,
+	0x14, 0xc7, 0xd7, 0xd8, 0x24, 0xf0, 0x08, 0x64, 0x32, 0x9b, 0x54, 0x56, 0xda, 0x22, 0x44, 0x55,
+	0x2d, 0xbb, 0x52, 0xa1, 0x4a, 0x7b, 0xda, 0xfe, 0x90, 0x80, 0x38, 0x91, 0xb5, 0xe1, 0x47, 0x07,
+	0xd8, 0xaa, 0x7b, 0x28, 0x1a, 0xf0, 0xc4, 0x8c, 0x16, 0x6c, 0x64, 0x0f, 0xac, 0x72, 0xeb, 0x9f,
+	0xd3, 0x6b, 0xff, 0x8b, 0x3d, 0xf4, 0xd0, 0x63, 0x4f, 0x55, 0xc3, 0x5f, 0xb0, 0xd2, 0x5e, 0xaa,
+	0x9e, 0xaa, 0x19, 0xdb, 0x40, 0x54, 0xd4, 0x72, 0x9b, 0xf7, 0x9d, 0xf7, 0xfd, 0xcc, 0xbc, 0x37,
+	0xcf, 0x32, 0x3c, 0x9d, 0xbf, 0x76, 0x6b, 0x74, 0xce, 0x6b, 0x7e, 0x58, 0x1b, 0xfb, 0x9e, 0xa0,
+	0xdc, 0x63, 0x41, 0x6d, 0x1e, 0xf8, 0x63, 0x16, 0x86, 0xb5, 0xe5, 0xe7, 0x72, 0xaf, 0x3a, 0x0f,
+	0x7c, 0xe1, 0xe3, 0x53, 0x3f, 0xac, 0xae, 0x53, 0xaa, 0x71, 0xca, 0xf9, 0xa9, 0xeb, 0xbb, 0xbe,
+	0x4a, 0xa8, 0xc9, 0x55, 0x94, 0x7b, 0xfe, 0xa1, 0xeb, 0xfb, 0xee, 0x94, 0xd5, 0x54, 0x34, 0x5a,
+	0xdc, 0xd6, 0xd8, 0x6c, 0x2e, 0xee, 0xe2, 0xcd, 0xb3, 0x25, 0x9d, 0x72, 0x87, 0x0a, 0xf6, 0x80,
+	0x5f, 0xfe, 0x3b, 0x05, 0xa8, 0x99, 0xf0, 0xbb, 0x11, 0x1e, 0x3f, 0x87, 0x8c, 0x60, 0xc1, 0x8c,
+	0x7b, 0x74, 0x6a, 0x6a, 0x25, 0xad, 0x92, 0xbb, 0x28, 0x56, 0x77, 0xdd, 0xa3, 0xda, 0x8f, 0xb3,
+	0xc8, 0x3a, 0x1f, 0x7f, 0x04, 0xfa, 0xf8, 0x8d, 0x63, 0xa6, 0x4a, 0x5a, 0x25, 0xdb, 0x80, 0xb7,
+	0xef, 0x4d, 0x6d, 0xf5, 0xde, 0x4c, 0xfd, 0x58, 0x23, 0x52, 0xc6, 0x5f, 0x81, 0xce, 0xbc, 0xa5,
+	0xa9, 0x97, 0xf4, 0x4a, 0xee, 0xe2, 0xe9, 0x6e, 0xa8, 0xe5, 0x2d, 0x79, 0xe0, 0x7b, 0x33, 0xe6,
+	0x89, 0x97, 0x34, 0xe0, 0x74, 0x34, 0x65, 0x44, 0xba, 0x30, 0x06, 0x83, 0x06, 0x6e, 0x68, 0x1a,
+	0x25, 0xbd, 0x92, 0x25, 0x6a, 0x8d, 0xbf, 0x81, 0xc3, 0x60, 0xca, 0x67, 0x5c, 0x84, 0x66, 0x5a,
+	0x41, 0x3f, 0xd9, 0x0d, 0x25, 0x2c, 0xf4, 0x17, 0xc1, 0x98, 0xdd, 0xc8, 0x5c, 0x92, 0x78, 0xf0,
+	0x15, 0x1c, 0x8d, 0xe9, 0x9c, 0x8e, 0xf8, 0x94, 0x0b, 0xce, 0x42, 0xf3, 0x40, 0x55, 0x5b, 0xde,
+	0xcd, 0x68, 0x6e, 0x65, 0x92, 0x07, 0x3e, 0xfc, 0x25, 0x18, 0x8b, 0x90, 0x05, 0xe6, 0xa1, 0xf2,
+	0x9f, 0xef, 0xf6, 0x0f, 0x42, 0x16, 0x34, 0x0c, 0xd9, 0x12, 0xa2, 0xb2, 0xcb, 0x5d, 0xc8, 0x24,
+	0x1d, 0xc4, 0x1f, 0xc0, 0x01, 0xf3, 0x64, 0xad, 0xaa, 0xe3, 0x19, 0x12, 0x47, 0x52, 0x9f, 0x30,
+	0xee, 0x4e, 0x84, 0x6a, 0x69, 0x9e, 0xc4, 0x11, 0x3e, 0x85, 0xf4, 0x1b, 0xee, 0x88, 0x89, 0xa9,
+	0x2b, 0x39, 0x0a, 0xca, 0x16, 0x3c, 0xde, 0xd1, 0x3e, 0x6c, 0x82, 0xe1, 0xd1, 0x59, 0x84, 0xce,
+	0x26, 0x57, 0x90, 0x8a, 0xc4, 0x2c, 0xe9, 0x74, 0xc1, 0xa2, 0x07, 0x23, 0x51, 0x50, 0xfe, 0x43,
+	0x83, 0xfc, 0x83, 0x8e, 0xe1, 0x3a, 0x18, 0xe2, 0x6e, 0x1e, 0x11, 0x0a, 0x17, 0x4f, 0xf6, 0x68,
+	0x72, 0xff, 0x6e, 0xce, 0x92, 0xa3, 0xa4, 0x15, 0x7f, 0x0c, 0x10, 0xfa, 0xb7, 0x62, 0xb8, 0x39,
+	0xcf, 0x20, 0x59, 0xa9, 0xbc, 0x94, 0x02, 0xfe, 0x14, 0x0a, 0x6a, 0x7b, 0xe1, 0xa9, 0xb7, 0x61,
+	0x8e, 0xaa, 0x2c, 0x43, 0xf2, 0x52, 0x1d, 0x24, 0xa2, 0xa4, 0x4c, 0x68, 0xe0, 0xc4, 0x14, 0x23,
+	0xa2, 0x48, 0x65, 0x4d, 0x51, 0xdb, 0x1b, 0x4a, 0x3a, 0xa2, 0x48, 0x75, 0x4d, 0x29, 0xff, 0x9a,
+	0x82, 0xa3, 0xed, 0xe7, 0xc4, 0xdf, 0x42, 0x76, 0x2e, 0x9f, 0x42, 0x48, 0x8b, 0x56, 0xd2, 0x2b,
+	0x85, 0x8b, 0xd2, 0xff, 0x4c, 0xc1, 0x1d, 0xd9, 0x58, 0xa4, 0x9f, 0xdd, 0xde, 0xb2, 0xb1, 0xe0,
+	0x4b, 0x59, 0xdb, 0x9e, 0xfe, 0xb5, 0x05, 0x37, 0x20, 0xc7, 0xbd, 0x09, 0x0b, 0xb8, 0x50, 0x33,
+	0xa0, 0xef, 0x49, 0xd8, 0x36, 0xe1, 0xaf, 0x21, 0x33, 0xf2, 0x17, 0x9e, 0xc3, 0x3d, 0xd7, 0x34,
+	0xf6, 0x04, 0xac, 0x1d, 0xf8, 0x39, 0x1c, 0xd2, 0xd9, 0x88, 0x33, 0x4f, 0x98, 0xe9, 0x3d, 0xcd,
+	0x89, 0xa1, 0xcc, 0xc1, 0x90, 0xc3, 0x8d, 0x11, 0xe8, 0x0b, 0xee, 0xa8, 0x21, 0xc9, 0x13, 0xb9,
+	0x94, 0x8a, 0xcb, 0x9d, 0x78, 0x76, 0xe5, 0x52, 0x4e, 0xdc, 0x62, 0x46, 0xc3, 0xd7, 0xc9, 0xe0,
+	0xaa, 0x00, 0x3f, 0x81, 0x63, 0xea, 0x38, 0x5c, 0x70, 0xdf, 0xa3, 0xd3, 0xa1, 0xcb, 0x9d, 0xe8,
+	0x33, 0xcf, 0x93, 0xc2, 0x46, 0xbe, 0xe6, 0x4e, 0xf8, 0xec, 0x9d, 0x06, 0x27, 0xff, 0x9a, 0x33,
+	0x7c, 0x0c, 0x39, 0x72, 0x63, 0xb7, 0xec, 0xfe, 0xb0, 0xdd, 0x69, 0x5b, 0xe8, 0x11, 0xce, 0x43,
+	0x36, 0x16, 0xea, 0x3d, 0xa4, 0xe1, 0x02, 0x40, 0x1c, 0x36, 0xbb, 0x03, 0x94, 0xda, 0xca, 0xbf,
+	0xac, 0xf7, 0xeb, 0x48, 0xc7, 0x08, 0x8e, 0x62, 0xe1, 0xaa, 0x67, 0xbf, 0xb2, 0x90, 0x81, 0x31,
+	0x14, 0x62, 0xa5, 0x65, 0xb5, 0x6e, 0x3a, 0xcd, 0x17, 0x28, 0x8d, 0x1f, 0xc3, 0x71, 0xa2, 0xf5,
+	0xae, 0xbf, 0x1b, 0x58, 0x03, 0x0b, 0x1d, 0xe0, 0x13, 0xc8, 0xaf, 0xcf, 0xbe, 0xb2, 0x6f, 0x2c,
+	0x74, 0xb8, 0x45, 0x6b, 0x77, 0x49, 0xa7, 0x89, 0x32, 0x5b, 0x49, 0xa4, 0xdf, 0xb7, 0x5b, 0x16,
+	0xca, 0xe2, 0x33, 0x38, 0x89, 0xa5, 0x9e, 0x7d, 0xdd, 0xb5, 0xda, 0x97, 0x76, 0xfb, 0x1a, 0xc1,
+	0x96, 0xb7, 0xd7, 0xaf, 0x37, 0x5f, 0xa0, 0xdc, 0xb3, 0x5f, 0x34, 0x80, 0x4d, 0xd7, 0xf1, 0x11,
+	0x64, 0x9a, 0xf5, 0x6e, 0x52, 0xe8, 0x19, 0x9c, 0xc8, 0xa8, 0x21, 0x6f, 0x38, 0xec, 0x0d, 0x7a,
+	0x12, 0x84, 0x34, 0x49, 0x91, 0xb2, 0xdd, 0x6d, 0x0e, 0xd5, 0xdd, 0x53, 0xf2, 0x06, 0xca, 0x66,
+	0xf5, 0x87, 0xf5, 0xcb, 0x96, 0xdd, 0x46, 0xba, 0xec, 0x82, 0x94, 0xba, 0x16, 0xb9, 0x6a, 0x75,
+	0xda, 0xc8, 0x48, 0x72, 0x7a, 0x3f, 0xf4, 0xe2, 0x9c, 0x74, 0x02, 0x92, 0x52, 0xa3, 0xd3, 0xe9,
+	0x47, 0xf5, 0x26, 0x0a, 0xa9, 0x7f, 0x6f, 0x77, 0xa2, 0x7a, 0x13, 0x49, 0x15, 0x97, 0x69, 0x5c,
+	0xfe, 0x7e, 0x5f, 0x7c, 0xf4, 0xee, 0xbe, 0xa8, 0xfd, 0x75, 0x5f, 0x7c, 0xf4, 0xd3, 0xaa, 0xa8,
+	0xfd, 0xbc, 0x2a, 0x6a, 0x6f, 0x57, 0x45, 0xed, 0xb7, 0x55, 0x51, 0xfb, 0x73, 0x55, 0xd4, 0x5e,
+	0x95, 0xe9, 0x54, 0x7c, 0xe6, 0x87, 0xff, 0xf5, 0x1f, 0x1c, 0x1d, 0xa8, 0x9f, 0xd4, 0x17, 0xff,
+	0x0c, 0x00, 0xed, 0xf7, 0xfd, 0x37, 0x31, 0x07, 0x00, 0x00,
 }
 
 func (this *ContainerProcess) Equal(that interface{}) bool {
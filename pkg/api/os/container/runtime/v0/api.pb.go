@@ -5,6 +5,7 @@ package v0
 
 import (
 	v0 "alt-os/api/os/machine/runtime/v0"
+	_ "alt-os/api/validate/v0"
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
@@ -79,7 +80,43 @@ type ApiServeRequest struct {
 	// The maximum number of containers to allow.
 	MaxContainers int64 `protobuf:"varint,4,opt,name=max_containers,json=maxContainers,proto3" json:"max_containers,omitempty"`
 	// The maximum amount of memory to allow a single container to consume.
-	MaxContainerMemory   int64    `protobuf:"varint,5,opt,name=max_container_memory,json=maxContainerMemory,proto3" json:"max_container_memory,omitempty"`
+	MaxContainerMemory int64 `protobuf:"varint,5,opt,name=max_container_memory,json=maxContainerMemory,proto3" json:"max_container_memory,omitempty"`
+	// The path of a unix domain socket for the API server to listen on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,6,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The file permission bits to set on the API server socket when it is
+	// created. Defaults to 0600 if not set.
+	ApiSocketMode uint32 `protobuf:"varint,7,opt,name=api_socket_mode,json=apiSocketMode,proto3" json:"api_socket_mode,omitempty"`
+	// The path of a PEM certificate for the API server to present to clients.
+	// Enables TLS for the API server if set.
+	ApiServerCertFile string `protobuf:"bytes,8,opt,name=api_server_cert_file,json=apiServerCertFile,proto3" json:"api_server_cert_file,omitempty"`
+	// The path of the PEM private key of the API server certificate.
+	ApiServerKeyFile string `protobuf:"bytes,9,opt,name=api_server_key_file,json=apiServerKeyFile,proto3" json:"api_server_key_file,omitempty"`
+	// The path of a PEM file of CA certificates to verify clients with.
+	// Clients without a certificate signed by one of these are rejected.
+	ApiClientCaFile string `protobuf:"bytes,10,opt,name=api_client_ca_file,json=apiClientCaFile,proto3" json:"api_client_ca_file,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,11,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,12,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,13,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,14,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile string `protobuf:"bytes,15,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
+	// The path of the authorization policy file of the API server, which grants
+	// each caller a role. All callers may call every method if not set.
+	ApiAuthPolicyFile string `protobuf:"bytes,16,opt,name=api_auth_policy_file,json=apiAuthPolicyFile,proto3" json:"api_auth_policy_file,omitempty"`
+	// The root directory of the runtime service, where its audit journal is kept.
+	RootDir string `protobuf:"bytes,17,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
+	// The port of an HTTP/JSON gateway to the API server to listen on at
+	// api_hostname, or at localhost if not set. No gateway is started if zero.
+	ApiHttpPort          uint32   `protobuf:"varint,18,opt,name=api_http_port,json=apiHttpPort,proto3" json:"api_http_port,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -152,6 +189,97 @@ func (m *ApiServeRequest) GetMaxContainerMemory() int64 {
 	return 0
 }
 
+func (m *ApiServeRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiSocketMode() uint32 {
+	if m != nil {
+		return m.ApiSocketMode
+	}
+	return 0
+}
+
+func (m *ApiServeRequest) GetApiServerCertFile() string {
+	if m != nil {
+		return m.ApiServerCertFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiServerKeyFile() string {
+	if m != nil {
+		return m.ApiServerKeyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiClientCaFile() string {
+	if m != nil {
+		return m.ApiClientCaFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ApiServeRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiAuthPolicyFile() string {
+	if m != nil {
+		return m.ApiAuthPolicyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetRootDir() string {
+	if m != nil {
+		return m.RootDir
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiHttpPort() uint32 {
+	if m != nil {
+		return m.ApiHttpPort
+	}
+	return 0
+}
+
 // ApiUnserveRequest specifies a ContainerRuntimeService.Unserve call.
 type ApiUnserveRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -159,7 +287,24 @@ type ApiUnserveRequest struct {
 	// The port of the listening API server to operate on.
 	ApiPort uint32 `protobuf:"varint,2,opt,name=api_port,json=apiPort,proto3" json:"api_port,omitempty"`
 	// The number of seconds to timeout the API request.
-	ApiTimeout           uint32   `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,4,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,5,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,6,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,7,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,8,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,9,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -218,6 +363,48 @@ func (m *ApiUnserveRequest) GetApiTimeout() uint32 {
 	return 0
 }
 
+func (m *ApiUnserveRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ApiUnserveRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // ListRequest specifies a ContainerRuntimeService.List call.
 type ListRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -225,7 +412,24 @@ type ListRequest struct {
 	// The port of the listening API server to operate on.
 	ApiPort uint32 `protobuf:"varint,2,opt,name=api_port,json=apiPort,proto3" json:"api_port,omitempty"`
 	// The number of seconds to timeout the API request.
-	ApiTimeout           uint32   `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,4,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,5,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,6,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,7,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,8,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,9,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -284,6 +488,48 @@ func (m *ListRequest) GetApiTimeout() uint32 {
 	return 0
 }
 
+func (m *ListRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ListRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ListRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ListRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ListRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ListRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // ListResponse returns the result of a ContainerRuntimeService.List call.
 type ListResponse struct {
 	// The hostname of the listening API server to operate on.
@@ -368,7 +614,24 @@ type QueryStateRequest struct {
 	// The number of seconds to timeout the API request.
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The unique id of the container.
-	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,5,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,6,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,7,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,8,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,9,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,10,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -434,6 +697,48 @@ func (m *QueryStateRequest) GetId() string {
 	return ""
 }
 
+func (m *QueryStateRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *QueryStateRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *QueryStateRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *QueryStateRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *QueryStateRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *QueryStateRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // QueryStateResponse returns the result of a ContainerRuntimeService.QueryState call.
 type QueryStateResponse struct {
 	// The request used to create the container's runtime.
@@ -511,7 +816,24 @@ type CreateRequest struct {
 	// The unique id of the container.
 	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
 	// The container's bundle directory.
-	Bundle               string   `protobuf:"bytes,5,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	Bundle string `protobuf:"bytes,5,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,6,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,7,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,8,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,9,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,10,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,11,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -584,6 +906,48 @@ func (m *CreateRequest) GetBundle() string {
 	return ""
 }
 
+func (m *CreateRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // StartRequest specifies a ContainerRuntimeService.Start call.
 type StartRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -593,7 +957,24 @@ type StartRequest struct {
 	// The number of seconds to timeout the API request.
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The unique id of the container.
-	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,5,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,6,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,7,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,8,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,9,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,10,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -659,6 +1040,48 @@ func (m *StartRequest) GetId() string {
 	return ""
 }
 
+func (m *StartRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *StartRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *StartRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *StartRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *StartRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *StartRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // KillRequest specifies a ContainerRuntimeService.Kill call.
 type KillRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -670,10 +1093,27 @@ type KillRequest struct {
 	// The unique id of the container.
 	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
 	// The kill signal to send.
-	Signal               v0.KillSignal `protobuf:"varint,5,opt,name=signal,proto3,enum=os.machine.runtime.KillSignal" json:"signal,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Signal v0.KillSignal `protobuf:"varint,5,opt,name=signal,proto3,enum=os.machine.runtime.KillSignal" json:"signal,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,6,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,7,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,8,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,9,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,10,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,11,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *KillRequest) Reset()      { *m = KillRequest{} }
@@ -743,6 +1183,48 @@ func (m *KillRequest) GetSignal() v0.KillSignal {
 	return v0.KillSignal_SIGNONE
 }
 
+func (m *KillRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *KillRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *KillRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *KillRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *KillRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *KillRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // DeleteRequest specifies a ContainerRuntimeService.Delete call.
 type DeleteRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -752,7 +1234,24 @@ type DeleteRequest struct {
 	// The number of seconds to timeout the API request.
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The unique id of the container.
-	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,5,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,6,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,7,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,8,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,9,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,10,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -818,9 +1317,51 @@ func (m *DeleteRequest) GetId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("os.container.runtime.ContainerStatus", ContainerStatus_name, ContainerStatus_value)
-	proto.RegisterType((*ApiServeRequest)(nil), "os.container.runtime.ApiServeRequest")
+func (m *DeleteRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *DeleteRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("os.container.runtime.ContainerStatus", ContainerStatus_name, ContainerStatus_value)
+	proto.RegisterType((*ApiServeRequest)(nil), "os.container.runtime.ApiServeRequest")
 	proto.RegisterType((*ApiUnserveRequest)(nil), "os.container.runtime.ApiUnserveRequest")
 	proto.RegisterType((*ListRequest)(nil), "os.container.runtime.ListRequest")
 	proto.RegisterType((*ListResponse)(nil), "os.container.runtime.ListResponse")
@@ -837,53 +1378,74 @@ func init() {
 }
 
 var fileDescriptor_a1bd00ecddb9a047 = []byte{
-	// 723 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xc1, 0x6e, 0x13, 0x49,
-	0x10, 0x75, 0xdb, 0x8e, 0x13, 0x97, 0x63, 0x27, 0x69, 0x45, 0x59, 0xaf, 0x57, 0x3b, 0x71, 0x66,
-	0x15, 0xad, 0x77, 0x25, 0x66, 0xa2, 0x20, 0x71, 0x0f, 0xb6, 0x09, 0x24, 0x90, 0x84, 0x71, 0x72,
-	0xe1, 0x62, 0x75, 0xec, 0xc6, 0x69, 0x98, 0x99, 0x1e, 0xa6, 0xdb, 0x51, 0x72, 0x00, 0x21, 0x3e,
-	0x82, 0x03, 0x5f, 0xc0, 0x07, 0xf0, 0x11, 0x70, 0xe3, 0xc8, 0x91, 0xf8, 0x0b, 0xb8, 0xc1, 0x11,
-	0x75, 0xcf, 0xc4, 0xb1, 0xc1, 0xb6, 0xb8, 0xe0, 0xdc, 0xa6, 0xba, 0x9e, 0xaa, 0x5f, 0x55, 0x57,
-	0xbd, 0x1a, 0xf8, 0x2f, 0x78, 0xda, 0xb1, 0x49, 0xc0, 0x6c, 0x2e, 0xec, 0x16, 0xf7, 0x25, 0x61,
-	0x3e, 0x0d, 0xed, 0xb0, 0xeb, 0x4b, 0xe6, 0x51, 0xfb, 0x74, 0x43, 0xf9, 0xac, 0x20, 0xe4, 0x92,
-	0xe3, 0x65, 0x2e, 0xac, 0x3e, 0xc4, 0x8a, 0x21, 0xa5, 0xe5, 0x0e, 0xef, 0x70, 0x0d, 0xb0, 0xd5,
-	0x57, 0x84, 0x2d, 0xfd, 0xd5, 0xe1, 0xbc, 0xe3, 0x52, 0x5b, 0x5b, 0xc7, 0xdd, 0xc7, 0x36, 0xf5,
-	0x02, 0x79, 0x1e, 0x3b, 0x57, 0xb9, 0xb0, 0x3d, 0xd2, 0x3a, 0x61, 0x3e, 0x1d, 0x79, 0x93, 0xf9,
-	0x01, 0xc1, 0xc2, 0x56, 0xc0, 0x1a, 0x34, 0x3c, 0xa5, 0x0e, 0x7d, 0xd6, 0xa5, 0x42, 0xe2, 0x35,
-	0x98, 0x27, 0x01, 0x6b, 0x9e, 0x70, 0x21, 0x7d, 0xe2, 0xd1, 0x22, 0x2a, 0xa3, 0x4a, 0xd6, 0xc9,
-	0x91, 0x80, 0xdd, 0x8d, 0x8f, 0xf0, 0x9f, 0x30, 0xa7, 0x20, 0x01, 0x0f, 0x65, 0x31, 0x59, 0x46,
-	0x95, 0xbc, 0x33, 0x4b, 0x02, 0x76, 0xc0, 0x43, 0x89, 0x57, 0x41, 0x21, 0x9b, 0xea, 0x2a, 0xde,
-	0x95, 0xc5, 0x94, 0xf6, 0x02, 0x09, 0xd8, 0x61, 0x74, 0x82, 0xd7, 0xa1, 0xe0, 0x91, 0xb3, 0x66,
-	0x3f, 0x3f, 0x51, 0x4c, 0x97, 0x51, 0x25, 0xe5, 0xe4, 0x3d, 0x72, 0x56, 0xed, 0x1f, 0xe2, 0x0d,
-	0x58, 0x1e, 0x82, 0x35, 0x3d, 0xea, 0xf1, 0xf0, 0xbc, 0x38, 0xa3, 0xc1, 0x78, 0x10, 0xfc, 0x40,
-	0x7b, 0xcc, 0x10, 0x96, 0xb6, 0x02, 0x76, 0xe4, 0x8b, 0xe9, 0x25, 0x63, 0xba, 0x90, 0xbb, 0xcf,
-	0x84, 0x9c, 0xd2, 0x6d, 0xcf, 0x61, 0x3e, 0xba, 0x4d, 0x04, 0xdc, 0x17, 0xf4, 0x77, 0xbf, 0x54,
-	0x01, 0x92, 0xac, 0x5d, 0x4c, 0x97, 0x53, 0x95, 0xac, 0x93, 0x64, 0x6d, 0xf3, 0x15, 0x82, 0xa5,
-	0x87, 0x5d, 0x1a, 0x9e, 0x37, 0x24, 0x91, 0xd3, 0x6a, 0x97, 0x4b, 0x12, 0x28, 0x26, 0xf1, 0x06,
-	0x01, 0x1e, 0x24, 0x11, 0x97, 0x62, 0x07, 0x0a, 0xad, 0x90, 0x12, 0x49, 0x9b, 0x61, 0xc4, 0x4b,
-	0xf3, 0xc8, 0x6d, 0xfe, 0x63, 0x8d, 0x9a, 0x25, 0xab, 0xaa, 0xb1, 0x71, 0x0a, 0x4e, 0xbe, 0x35,
-	0x68, 0xe2, 0xbf, 0x01, 0x8e, 0xbb, 0x7e, 0xdb, 0xa5, 0xcd, 0x36, 0x0b, 0x35, 0xe1, 0xac, 0x93,
-	0x8d, 0x4e, 0x6a, 0x2c, 0x54, 0xd9, 0xf0, 0x16, 0x6b, 0x3e, 0x11, 0xdc, 0xd7, 0x7c, 0xb3, 0xce,
-	0x2c, 0x6f, 0xb1, 0x1d, 0xc1, 0x7d, 0xf3, 0x35, 0x82, 0xfc, 0x50, 0xe8, 0x29, 0x57, 0x07, 0xaf,
-	0x40, 0x26, 0x22, 0xaa, 0xe7, 0x24, 0xeb, 0xc4, 0x96, 0xea, 0x9c, 0x86, 0x24, 0xa1, 0xbc, 0xa6,
-	0x47, 0x7b, 0x87, 0x20, 0xb7, 0xcb, 0x5c, 0xf7, 0x9a, 0xaa, 0x72, 0x0b, 0x32, 0x82, 0x75, 0x7c,
-	0xe2, 0xea, 0xaa, 0x14, 0x36, 0x0d, 0xd5, 0x14, 0xb1, 0x2e, 0xf6, 0x5b, 0x42, 0xf1, 0x6b, 0x68,
-	0x94, 0x13, 0xa3, 0xcd, 0x17, 0x90, 0xaf, 0x51, 0x97, 0x5e, 0xd7, 0x6b, 0xfe, 0x7f, 0x07, 0x16,
-	0xfa, 0x22, 0xa7, 0xda, 0xbd, 0x2b, 0xf0, 0x3c, 0xcc, 0x55, 0x9d, 0xfa, 0xd6, 0xe1, 0xbd, 0xbd,
-	0xed, 0xc5, 0x04, 0xce, 0xc1, 0xac, 0xb6, 0xea, 0xb5, 0x45, 0xa4, 0x0c, 0xe7, 0x68, 0x6f, 0x4f,
-	0x79, 0x92, 0xca, 0x68, 0x1c, 0xee, 0x1f, 0x1c, 0xd4, 0x6b, 0x8b, 0xa9, 0xcd, 0xaf, 0x69, 0xf8,
-	0xa3, 0x1f, 0xc8, 0x89, 0xf2, 0x55, 0x92, 0xcf, 0x5a, 0x14, 0xef, 0xc2, 0xdc, 0xe5, 0x02, 0xc0,
-	0xeb, 0xa3, 0x87, 0xe5, 0x87, 0x05, 0x51, 0x5a, 0xb1, 0xa2, 0x9d, 0x63, 0x5d, 0xee, 0x1c, 0xab,
-	0xae, 0x76, 0x8e, 0x99, 0xc0, 0xfb, 0x00, 0x57, 0x12, 0x8c, 0xff, 0x1d, 0x1b, 0x6e, 0x58, 0xa4,
-	0x27, 0x06, 0x4c, 0x2b, 0xc5, 0xc3, 0x6b, 0xa3, 0x43, 0x0d, 0x68, 0x6f, 0xc9, 0x9c, 0x04, 0x89,
-	0x54, 0x22, 0x62, 0x78, 0xa5, 0x1e, 0xe3, 0x18, 0xfe, 0x24, 0x72, 0x13, 0x18, 0x6e, 0x43, 0x26,
-	0x9a, 0x78, 0xfc, 0x2b, 0x52, 0x33, 0x21, 0x50, 0x1d, 0x66, 0xf4, 0x88, 0xe2, 0x31, 0x89, 0x0c,
-	0xce, 0xef, 0x84, 0x30, 0x55, 0x48, 0xab, 0x4e, 0x1e, 0x57, 0xb1, 0x81, 0x29, 0x9c, 0x9c, 0x54,
-	0xd4, 0xf8, 0xe3, 0x92, 0x1a, 0x1a, 0x8b, 0xf1, 0x81, 0x6e, 0xd7, 0x3e, 0x5d, 0x18, 0x89, 0x2f,
-	0x17, 0x06, 0xfa, 0x76, 0x61, 0x24, 0x5e, 0xf6, 0x0c, 0xf4, 0xb6, 0x67, 0xa0, 0xf7, 0x3d, 0x03,
-	0x7d, 0xec, 0x19, 0xe8, 0x73, 0xcf, 0x40, 0x8f, 0x4c, 0xe2, 0xca, 0x1b, 0x5c, 0x4c, 0xfa, 0x33,
-	0x3a, 0xce, 0xe8, 0xb8, 0x37, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0x17, 0x46, 0xe7, 0xe3, 0x43,
-	0x09, 0x00, 0x00,
+	// 1069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x98, 0xbf, 0x73, 0x1b, 0x45,
+	0x14, 0xc7, 0x7d, 0x27, 0x59, 0x3f, 0x9e, 0x2c, 0x59, 0x5e, 0x4c, 0x38, 0x9b, 0x41, 0x71, 0xc4,
+	0x98, 0x38, 0x30, 0xd1, 0x65, 0xcc, 0x0c, 0xbd, 0x23, 0x2b, 0x81, 0x98, 0x38, 0xe6, 0xa4, 0x34,
+	0x34, 0x37, 0xeb, 0xd3, 0x46, 0x5e, 0x7c, 0xba, 0x3d, 0xf6, 0x56, 0x9e, 0xa8, 0x60, 0x86, 0x8a,
+	0x3f, 0x82, 0x9a, 0x82, 0x3f, 0x25, 0x25, 0x15, 0x93, 0x82, 0x02, 0xab, 0x87, 0x61, 0xa0, 0x80,
+	0x92, 0xd9, 0xdd, 0xd3, 0x59, 0x32, 0x92, 0xb8, 0x02, 0x9c, 0x22, 0xee, 0xbc, 0xbb, 0x1f, 0xbd,
+	0x7b, 0xfb, 0xbe, 0x6f, 0xf7, 0xed, 0x33, 0xdc, 0x09, 0x4f, 0x7b, 0x36, 0x0e, 0xa9, 0xcd, 0x22,
+	0xdb, 0x63, 0x81, 0xc0, 0x34, 0x20, 0xdc, 0xe6, 0x83, 0x40, 0xd0, 0x3e, 0xb1, 0xcf, 0xee, 0xc9,
+	0xb5, 0x46, 0xc8, 0x99, 0x60, 0x68, 0x9d, 0x45, 0x8d, 0x04, 0x69, 0xc4, 0xc8, 0xe6, 0x7a, 0x8f,
+	0xf5, 0x98, 0x02, 0x6c, 0xf9, 0x97, 0x66, 0x37, 0xdf, 0xee, 0x31, 0xd6, 0xf3, 0x89, 0xad, 0x46,
+	0xc7, 0x83, 0x67, 0x36, 0xe9, 0x87, 0x62, 0x18, 0x2f, 0xde, 0x64, 0x91, 0xdd, 0xc7, 0xde, 0x09,
+	0x0d, 0xc8, 0xcc, 0x2f, 0x6d, 0xbe, 0x79, 0x86, 0x7d, 0xda, 0xc5, 0x62, 0x7a, 0xba, 0xfe, 0x72,
+	0x19, 0x56, 0xf7, 0x42, 0xda, 0x26, 0xfc, 0x8c, 0x38, 0xe4, 0xcb, 0x01, 0x89, 0x04, 0xba, 0x05,
+	0x2b, 0x38, 0xa4, 0xee, 0x09, 0x8b, 0x44, 0x80, 0xfb, 0xc4, 0x32, 0xb6, 0x8c, 0x9d, 0xa2, 0x53,
+	0xc2, 0x21, 0xfd, 0x38, 0x9e, 0x42, 0x1b, 0x50, 0x90, 0x48, 0xc8, 0xb8, 0xb0, 0xcc, 0x2d, 0x63,
+	0xa7, 0xec, 0xe4, 0x71, 0x48, 0x8f, 0x18, 0x17, 0xe8, 0x26, 0x48, 0xd2, 0x95, 0x1e, 0xb0, 0x81,
+	0xb0, 0x32, 0x6a, 0x15, 0x70, 0x48, 0x3b, 0x7a, 0x06, 0x6d, 0x43, 0xa5, 0x8f, 0x9f, 0xbb, 0xc9,
+	0xb6, 0x23, 0x2b, 0xbb, 0x65, 0xec, 0x64, 0x9c, 0x72, 0x1f, 0x3f, 0x6f, 0x26, 0x93, 0xe8, 0x1e,
+	0xac, 0x4f, 0x61, 0x6e, 0x9f, 0xf4, 0x19, 0x1f, 0x5a, 0xcb, 0x0a, 0x46, 0x93, 0xf0, 0x63, 0xb5,
+	0x82, 0xde, 0x01, 0xf9, 0x19, 0x37, 0x62, 0xde, 0x29, 0x11, 0x56, 0x4e, 0x79, 0x5d, 0xc4, 0x21,
+	0x6d, 0xab, 0x09, 0xf4, 0x1e, 0xac, 0x5e, 0x2c, 0xbb, 0x7d, 0xd6, 0x25, 0x56, 0x5e, 0x39, 0x57,
+	0x4e, 0x98, 0xc7, 0xac, 0x4b, 0x90, 0x0d, 0xeb, 0x8a, 0x93, 0x21, 0xe1, 0xae, 0x47, 0xb8, 0x70,
+	0x9f, 0x51, 0x9f, 0x58, 0x05, 0x65, 0x70, 0x0d, 0xc7, 0xd1, 0xe2, 0x4d, 0xc2, 0xc5, 0x03, 0xea,
+	0x13, 0x74, 0x17, 0xde, 0x98, 0xf8, 0xc1, 0x29, 0x19, 0x6a, 0xbe, 0xa8, 0xf8, 0x6a, 0xc2, 0x1f,
+	0x90, 0xa1, 0xc2, 0x3f, 0x00, 0x24, 0x71, 0xcf, 0xa7, 0x24, 0x10, 0xae, 0x87, 0x35, 0x0d, 0x8a,
+	0x96, 0x1e, 0x36, 0xd5, 0x42, 0x13, 0x2b, 0x78, 0x5b, 0x3b, 0x2d, 0xfc, 0x28, 0x21, 0x4b, 0x8a,
+	0x94, 0x12, 0x75, 0xfc, 0x28, 0xc6, 0xee, 0xc0, 0x5a, 0x82, 0x25, 0x0e, 0xaf, 0x28, 0xb0, 0x12,
+	0x83, 0x63, 0x6f, 0x6f, 0x43, 0x75, 0x8c, 0x26, 0xae, 0x96, 0x15, 0x59, 0xd6, 0xe4, 0xd8, 0xcf,
+	0x58, 0x48, 0x4e, 0x04, 0xa7, 0x24, 0xb2, 0x2a, 0x89, 0x90, 0x8e, 0x9e, 0x19, 0xef, 0x1b, 0x0f,
+	0xc4, 0x89, 0x2b, 0xd8, 0x29, 0x09, 0xb4, 0xb1, 0xd5, 0x64, 0xdf, 0x7b, 0x03, 0x71, 0xd2, 0x91,
+	0x0b, 0xca, 0x5e, 0x1c, 0x57, 0x85, 0x87, 0xcc, 0xa7, 0x5e, 0xfc, 0xf1, 0x6a, 0x12, 0x57, 0xc9,
+	0x1f, 0xa9, 0x15, 0xf5, 0x83, 0x0d, 0x28, 0x70, 0xc6, 0x84, 0xdb, 0xa5, 0xdc, 0x5a, 0x53, 0x50,
+	0x5e, 0x8e, 0xf7, 0x29, 0x47, 0x75, 0x28, 0xab, 0x14, 0x15, 0x22, 0xd4, 0x49, 0x88, 0x94, 0x77,
+	0x2a, 0x47, 0x85, 0x08, 0x65, 0x22, 0xd6, 0x7f, 0x32, 0x61, 0x6d, 0x2f, 0xa4, 0x4f, 0x83, 0xe8,
+	0x0a, 0x93, 0x7b, 0x3a, 0x07, 0xb3, 0x97, 0x73, 0x70, 0x86, 0x9c, 0xcb, 0x69, 0xe5, 0xcc, 0xa5,
+	0x96, 0x33, 0x9f, 0x42, 0xce, 0x42, 0x5a, 0x39, 0x8b, 0xb3, 0xe5, 0xac, 0xff, 0x68, 0x42, 0xe9,
+	0x53, 0x1a, 0x89, 0xeb, 0xc0, 0xfe, 0xc7, 0x81, 0xfd, 0x0a, 0x56, 0x74, 0x5c, 0xa3, 0x90, 0x05,
+	0x11, 0xf9, 0xbf, 0x03, 0x5b, 0x01, 0x93, 0x76, 0xad, 0xec, 0x56, 0x66, 0xa7, 0xe8, 0x98, 0xb4,
+	0x5b, 0xff, 0xdd, 0x84, 0xb5, 0xcf, 0x06, 0x84, 0x0f, 0xdb, 0x02, 0x8b, 0xab, 0x3a, 0x36, 0xeb,
+	0xb1, 0x13, 0xc6, 0x4e, 0xf1, 0x7e, 0xf6, 0xc5, 0x1f, 0x96, 0x21, 0x5d, 0xb9, 0xa4, 0xf9, 0x72,
+	0x0a, 0xcd, 0x73, 0x69, 0x35, 0xcf, 0xa7, 0xd6, 0xbc, 0x90, 0x42, 0xf3, 0x62, 0x5a, 0xcd, 0x61,
+	0x8e, 0xe6, 0xdf, 0x1a, 0x80, 0x26, 0x83, 0x1e, 0x4b, 0xff, 0x08, 0x2a, 0x1e, 0x27, 0x58, 0x10,
+	0x97, 0x6b, 0x1d, 0x54, 0xdc, 0x4b, 0xbb, 0xef, 0x36, 0x66, 0xbd, 0x1b, 0x1a, 0x4d, 0x4e, 0x2e,
+	0x24, 0x73, 0xca, 0xde, 0xe4, 0x50, 0x06, 0xf3, 0x78, 0x10, 0x74, 0x7d, 0xa2, 0xee, 0x53, 0x53,
+	0x07, 0x53, 0xcf, 0xc8, 0x1b, 0x75, 0x03, 0x0a, 0xcc, 0xa3, 0xee, 0x17, 0x11, 0x0b, 0x94, 0x3e,
+	0x45, 0x27, 0xcf, 0x3c, 0xfa, 0x28, 0x62, 0x41, 0xfd, 0x9b, 0x0c, 0x94, 0xa7, 0x4c, 0xbf, 0x9a,
+	0x6c, 0xb8, 0x01, 0x39, 0xed, 0x6e, 0x9c, 0x09, 0xf1, 0xe8, 0xdf, 0xca, 0xfe, 0x8c, 0x2c, 0xc9,
+	0xa7, 0xcd, 0x92, 0x42, 0xea, 0x2c, 0x29, 0xa6, 0xc8, 0x12, 0x48, 0x9b, 0x25, 0xa5, 0x39, 0x59,
+	0xf2, 0x8b, 0x09, 0x2b, 0x6d, 0x81, 0xb9, 0xb8, 0x3e, 0x95, 0x57, 0x72, 0x2a, 0xbf, 0xcb, 0x40,
+	0xe9, 0x80, 0xfa, 0xfe, 0x2b, 0x0d, 0xf7, 0x47, 0x90, 0x8b, 0x68, 0x2f, 0xc0, 0xbe, 0x0a, 0x75,
+	0x65, 0xb7, 0x26, 0xcf, 0x7e, 0xfc, 0xd4, 0x4f, 0x4e, 0xbe, 0xf4, 0xb2, 0xad, 0x28, 0x27, 0xa6,
+	0x5f, 0xa3, 0x63, 0xf1, 0xab, 0x09, 0xe5, 0x7d, 0xe2, 0x93, 0xeb, 0x6a, 0x75, 0x35, 0xe7, 0xe2,
+	0xfd, 0x07, 0xb0, 0x9a, 0xf4, 0x5e, 0xb2, 0x60, 0x0d, 0x22, 0xb4, 0x02, 0x85, 0xa6, 0xd3, 0xda,
+	0xeb, 0x7c, 0x72, 0xf8, 0xb0, 0xba, 0x84, 0x4a, 0x90, 0x57, 0xa3, 0xd6, 0x7e, 0xd5, 0x90, 0x03,
+	0xe7, 0xe9, 0xe1, 0xa1, 0x5c, 0x31, 0xe5, 0xa0, 0xdd, 0x79, 0x72, 0x74, 0xd4, 0xda, 0xaf, 0x66,
+	0x76, 0xff, 0xcc, 0xc2, 0x5b, 0x89, 0x21, 0x47, 0xa7, 0xb2, 0xec, 0x95, 0xa8, 0x47, 0xd0, 0x01,
+	0x14, 0xc6, 0x7d, 0x29, 0xda, 0x9e, 0x5d, 0xee, 0x2e, 0xf5, 0xad, 0x9b, 0x37, 0x1a, 0xba, 0x43,
+	0x6e, 0x8c, 0x3b, 0xe4, 0x46, 0x4b, 0x76, 0xc8, 0xf5, 0x25, 0xf4, 0x04, 0xe0, 0xa2, 0x13, 0x40,
+	0xb7, 0xe7, 0x9a, 0x9b, 0xee, 0x15, 0x16, 0x1a, 0xcc, 0xca, 0x37, 0x1a, 0xba, 0x35, 0xdb, 0xd4,
+	0xc4, 0xbb, 0x78, 0xb3, 0xbe, 0x08, 0xd1, 0x75, 0x5e, 0x7b, 0x78, 0x51, 0xff, 0xe7, 0x79, 0xf8,
+	0x8f, 0x67, 0xd9, 0x02, 0x0f, 0x1f, 0x42, 0x4e, 0xd7, 0x6c, 0x94, 0xe6, 0xb1, 0xb0, 0xc0, 0x50,
+	0x0b, 0x96, 0x55, 0xcd, 0x41, 0x73, 0x36, 0x32, 0x59, 0x90, 0x16, 0x98, 0x69, 0x42, 0x56, 0x5e,
+	0x52, 0xf3, 0x22, 0x36, 0x71, 0xcd, 0x2e, 0xde, 0x94, 0x3e, 0xe8, 0xf3, 0x36, 0x35, 0x75, 0x0d,
+	0xcc, 0x37, 0x74, 0x7f, 0xff, 0xe5, 0x79, 0x6d, 0xe9, 0xb7, 0xf3, 0x9a, 0xf1, 0xd7, 0x79, 0x6d,
+	0xe9, 0xeb, 0x51, 0xcd, 0xf8, 0x7e, 0x54, 0x33, 0x5e, 0x8c, 0x6a, 0xc6, 0x0f, 0xa3, 0x9a, 0xf1,
+	0xf3, 0xa8, 0x66, 0x7c, 0x5e, 0xc7, 0xbe, 0xb8, 0xcb, 0xa2, 0x45, 0xff, 0xc7, 0x39, 0xce, 0x29,
+	0xbb, 0x1f, 0xfe, 0x3d, 0x00, 0x45, 0xe4, 0xb8, 0xdc, 0xf1, 0x11, 0x00, 0x00,
 }
 
 func (this *ApiServeRequest) Equal(that interface{}) bool {
@@ -920,6 +1482,45 @@ func (this *ApiServeRequest) Equal(that interface{}) bool {
 	if this.MaxContainerMemory != that1.MaxContainerMemory {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiSocketMode != that1.ApiSocketMode {
+		return false
+	}
+	if this.ApiServerCertFile != that1.ApiServerCertFile {
+		return false
+	}
+	if this.ApiServerKeyFile != that1.ApiServerKeyFile {
+		return false
+	}
+	if this.ApiClientCaFile != that1.ApiClientCaFile {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
+	if this.ApiAuthPolicyFile != that1.ApiAuthPolicyFile {
+		return false
+	}
+	if this.RootDir != that1.RootDir {
+		return false
+	}
+	if this.ApiHttpPort != that1.ApiHttpPort {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -953,6 +1554,24 @@ func (this *ApiUnserveRequest) Equal(that interface{}) bool {
 	if this.ApiTimeout != that1.ApiTimeout {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -986,6 +1605,24 @@ func (this *ListRequest) Equal(that interface{}) bool {
 	if this.ApiTimeout != that1.ApiTimeout {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1063,6 +1700,24 @@ func (this *QueryStateRequest) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1135,6 +1790,24 @@ func (this *CreateRequest) Equal(that interface{}) bool {
 	if this.Bundle != that1.Bundle {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1171,6 +1844,24 @@ func (this *StartRequest) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1210,6 +1901,24 @@ func (this *KillRequest) Equal(that interface{}) bool {
 	if this.Signal != that1.Signal {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1246,6 +1955,24 @@ func (this *DeleteRequest) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1255,13 +1982,26 @@ func (this *ApiServeRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 22)
 	s = append(s, "&v0.ApiServeRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "MaxContainers: "+fmt.Sprintf("%#v", this.MaxContainers)+",\n")
 	s = append(s, "MaxContainerMemory: "+fmt.Sprintf("%#v", this.MaxContainerMemory)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiSocketMode: "+fmt.Sprintf("%#v", this.ApiSocketMode)+",\n")
+	s = append(s, "ApiServerCertFile: "+fmt.Sprintf("%#v", this.ApiServerCertFile)+",\n")
+	s = append(s, "ApiServerKeyFile: "+fmt.Sprintf("%#v", this.ApiServerKeyFile)+",\n")
+	s = append(s, "ApiClientCaFile: "+fmt.Sprintf("%#v", this.ApiClientCaFile)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
+	s = append(s, "ApiAuthPolicyFile: "+fmt.Sprintf("%#v", this.ApiAuthPolicyFile)+",\n")
+	s = append(s, "RootDir: "+fmt.Sprintf("%#v", this.RootDir)+",\n")
+	s = append(s, "ApiHttpPort: "+fmt.Sprintf("%#v", this.ApiHttpPort)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1272,11 +2012,17 @@ func (this *ApiUnserveRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 13)
 	s = append(s, "&v0.ApiUnserveRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1287,11 +2033,17 @@ func (this *ListRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 13)
 	s = append(s, "&v0.ListRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1318,12 +2070,18 @@ func (this *QueryStateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 14)
 	s = append(s, "&v0.QueryStateRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1351,13 +2109,19 @@ func (this *CreateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 15)
 	s = append(s, "&v0.CreateRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "Bundle: "+fmt.Sprintf("%#v", this.Bundle)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1368,12 +2132,18 @@ func (this *StartRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 14)
 	s = append(s, "&v0.StartRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1384,13 +2154,19 @@ func (this *KillRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 15)
 	s = append(s, "&v0.KillRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "Signal: "+fmt.Sprintf("%#v", this.Signal)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1401,12 +2177,18 @@ func (this *DeleteRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 14)
 	s = append(s, "&v0.DeleteRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1794,6 +2576,97 @@ func (m *ApiServeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ApiHttpPort != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiHttpPort))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.RootDir) > 0 {
+		i -= len(m.RootDir)
+		copy(dAtA[i:], m.RootDir)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.RootDir)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ApiAuthPolicyFile) > 0 {
+		i -= len(m.ApiAuthPolicyFile)
+		copy(dAtA[i:], m.ApiAuthPolicyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthPolicyFile)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ApiClientCaFile) > 0 {
+		i -= len(m.ApiClientCaFile)
+		copy(dAtA[i:], m.ApiClientCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiClientCaFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ApiServerKeyFile) > 0 {
+		i -= len(m.ApiServerKeyFile)
+		copy(dAtA[i:], m.ApiServerKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiServerKeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiServerCertFile) > 0 {
+		i -= len(m.ApiServerCertFile)
+		copy(dAtA[i:], m.ApiServerCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiServerCertFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ApiSocketMode != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiSocketMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.MaxContainerMemory != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.MaxContainerMemory))
 		i--
@@ -1848,6 +2721,46 @@ func (m *ApiUnserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.ApiTimeout != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.ApiTimeout))
 		i--
@@ -1892,6 +2805,46 @@ func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.ApiTimeout != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.ApiTimeout))
 		i--
@@ -1989,6 +2942,46 @@ func (m *QueryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -2093,6 +3086,46 @@ func (m *CreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Bundle) > 0 {
 		i -= len(m.Bundle)
 		copy(dAtA[i:], m.Bundle)
@@ -2151,6 +3184,46 @@ func (m *StartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -2202,6 +3275,46 @@ func (m *KillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Signal != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.Signal))
 		i--
@@ -2258,7 +3371,47 @@ func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Id) > 0 {
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
 		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
@@ -2318,6 +3471,55 @@ func (m *ApiServeRequest) Size() (n int) {
 	if m.MaxContainerMemory != 0 {
 		n += 1 + sovApi(uint64(m.MaxContainerMemory))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiSocketMode != 0 {
+		n += 1 + sovApi(uint64(m.ApiSocketMode))
+	}
+	l = len(m.ApiServerCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiServerKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiClientCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiAuthPolicyFile)
+	if l > 0 {
+		n += 2 + l + sovApi(uint64(l))
+	}
+	l = len(m.RootDir)
+	if l > 0 {
+		n += 2 + l + sovApi(uint64(l))
+	}
+	if m.ApiHttpPort != 0 {
+		n += 2 + sovApi(uint64(m.ApiHttpPort))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2340,6 +3542,29 @@ func (m *ApiUnserveRequest) Size() (n int) {
 	if m.ApiTimeout != 0 {
 		n += 1 + sovApi(uint64(m.ApiTimeout))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2362,6 +3587,29 @@ func (m *ListRequest) Size() (n int) {
 	if m.ApiTimeout != 0 {
 		n += 1 + sovApi(uint64(m.ApiTimeout))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2416,6 +3664,29 @@ func (m *QueryStateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2470,6 +3741,29 @@ func (m *CreateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2496,6 +3790,29 @@ func (m *StartRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2525,6 +3842,29 @@ func (m *KillRequest) Size() (n int) {
 	if m.Signal != 0 {
 		n += 1 + sovApi(uint64(m.Signal))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2551,6 +3891,29 @@ func (m *DeleteRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2573,6 +3936,19 @@ func (this *ApiServeRequest) String() string {
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`MaxContainers:` + fmt.Sprintf("%v", this.MaxContainers) + `,`,
 		`MaxContainerMemory:` + fmt.Sprintf("%v", this.MaxContainerMemory) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiSocketMode:` + fmt.Sprintf("%v", this.ApiSocketMode) + `,`,
+		`ApiServerCertFile:` + fmt.Sprintf("%v", this.ApiServerCertFile) + `,`,
+		`ApiServerKeyFile:` + fmt.Sprintf("%v", this.ApiServerKeyFile) + `,`,
+		`ApiClientCaFile:` + fmt.Sprintf("%v", this.ApiClientCaFile) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
+		`ApiAuthPolicyFile:` + fmt.Sprintf("%v", this.ApiAuthPolicyFile) + `,`,
+		`RootDir:` + fmt.Sprintf("%v", this.RootDir) + `,`,
+		`ApiHttpPort:` + fmt.Sprintf("%v", this.ApiHttpPort) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2586,6 +3962,12 @@ func (this *ApiUnserveRequest) String() string {
 		`ApiHostname:` + fmt.Sprintf("%v", this.ApiHostname) + `,`,
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2599,6 +3981,12 @@ func (this *ListRequest) String() string {
 		`ApiHostname:` + fmt.Sprintf("%v", this.ApiHostname) + `,`,
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2627,6 +4015,12 @@ func (this *QueryStateRequest) String() string {
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2655,6 +4049,12 @@ func (this *CreateRequest) String() string {
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`Bundle:` + fmt.Sprintf("%v", this.Bundle) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2669,6 +4069,12 @@ func (this *StartRequest) String() string {
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2684,6 +4090,12 @@ func (this *KillRequest) String() string {
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2698,6 +4110,12 @@ func (this *DeleteRequest) String() string {
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2848,60 +4266,9 @@ func (m *ApiServeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApiUnserveRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApiUnserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2929,13 +4296,13 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocketMode", wireType)
 			}
-			m.ApiPort = 0
+			m.ApiSocketMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2945,16 +4312,16 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				m.ApiSocketMode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiServerCertFile", wireType)
 			}
-			m.ApiTimeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2964,43 +4331,1586 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
+			m.ApiServerCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiServerKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiServerKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiClientCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiClientCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthPolicyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthPolicyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHttpPort", wireType)
+			}
+			m.ApiHttpPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiHttpPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiUnserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiUnserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateRequest == nil {
+				m.CreateRequest = &CreateRequest{}
+			}
+			if err := m.CreateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OciJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OciJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3010,19 +5920,121 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3050,13 +6062,13 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.Bundle = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3066,16 +6078,29 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ApiTimeout = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3085,65 +6110,27 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3171,13 +6158,13 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3187,16 +6174,29 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
 			}
-			m.ApiTimeout = 0
+			m.ApiRetries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3206,14 +6206,14 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				m.ApiRetries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3241,7 +6241,7 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id, string(dAtA[iNdEx:postIndex]))
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3265,7 +6265,7 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+func (m *StartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,10 +6288,10 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3396,62 +6396,43 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3461,31 +6442,59 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateRequest == nil {
-				m.CreateRequest = &CreateRequest{}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
 			}
-			if err := m.CreateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3513,11 +6522,30 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleDir = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OciJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3545,7 +6573,7 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OciJson = string(dAtA[iNdEx:postIndex])
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3569,7 +6597,7 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+func (m *KillRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3592,10 +6620,10 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KillRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3701,8 +6729,27 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			m.Signal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Signal |= v0.KillSignal(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3730,62 +6777,43 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bundle = string(dAtA[iNdEx:postIndex])
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3813,13 +6841,13 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3829,16 +6857,29 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
 			}
-			m.ApiTimeout = 0
+			m.ApiRetries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3848,14 +6889,14 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				m.ApiRetries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3883,7 +6924,7 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3907,7 +6948,7 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KillRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3930,10 +6971,10 @@ func (m *KillRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KillRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4039,10 +7080,10 @@ func (m *KillRequest) Unmarshal(dAtA []byte) error {
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
-			m.Signal = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4052,65 +7093,59 @@ func (m *KillRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Signal |= v0.KillSignal(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4138,13 +7173,13 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4154,16 +7189,29 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
 			}
-			m.ApiTimeout = 0
+			m.ApiRetries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4173,14 +7221,14 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				m.ApiRetries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4208,7 +7256,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
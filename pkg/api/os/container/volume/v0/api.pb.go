@@ -4,6 +4,7 @@
 package v0
 
 import (
+	_ "alt-os/api/validate/v0"
 	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -111,23 +112,23 @@ func init() {
 }
 
 var fileDescriptor_bd9e4d54f375948c = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
+	// 256 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0xc8, 0x4e, 0xd7,
 	0x4f, 0x2c, 0xc8, 0xd4, 0xcf, 0x2f, 0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x49, 0xcc, 0xcc, 0x4b, 0x2d,
 	0xd2, 0x2f, 0xcb, 0xcf, 0x29, 0xcd, 0x4d, 0xd5, 0x2f, 0x33, 0x00, 0x49, 0xe9, 0x15, 0x14, 0xe5,
 	0x97, 0xe4, 0x0b, 0x09, 0xe7, 0x17, 0xeb, 0xc1, 0x55, 0xe8, 0x41, 0x54, 0x48, 0x89, 0xa4, 0xe7,
 	0xa7, 0xe7, 0x83, 0xe5, 0xf5, 0x41, 0x2c, 0x88, 0x52, 0x29, 0xe9, 0xf4, 0xfc, 0xfc, 0xf4, 0x9c,
-	0x54, 0x7d, 0x30, 0x2f, 0xa9, 0x34, 0x4d, 0x3f, 0x35, 0xb7, 0xa0, 0xa4, 0x12, 0x22, 0xa9, 0x54,
-	0xc9, 0xc5, 0xef, 0x0c, 0x33, 0x26, 0x0c, 0x6c, 0x8a, 0x90, 0x02, 0x17, 0x77, 0x4a, 0x6a, 0x71,
-	0x49, 0x66, 0x5e, 0x62, 0x49, 0x66, 0x7e, 0x9e, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0xb2,
-	0x90, 0x90, 0x18, 0x17, 0x5b, 0x71, 0x7e, 0x69, 0x51, 0x72, 0xaa, 0x04, 0x13, 0x58, 0x12, 0xca,
-	0x13, 0x12, 0xe2, 0x62, 0x29, 0xa9, 0x2c, 0x48, 0x95, 0x60, 0x06, 0x8b, 0x82, 0xd9, 0x42, 0x12,
-	0x5c, 0xec, 0xf9, 0x05, 0x20, 0x5d, 0xc5, 0x12, 0x2c, 0x0a, 0xcc, 0x1a, 0x9c, 0x41, 0x30, 0xae,
-	0x93, 0xf3, 0x8d, 0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca, 0x31, 0xfe, 0x78, 0x28, 0xc7, 0xd0, 0xf0,
-	0x48, 0x8e, 0x71, 0xc5, 0x23, 0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c,
-	0xf0, 0x48, 0x8e, 0x31, 0x4a, 0x31, 0x31, 0xa7, 0x44, 0x37, 0xbf, 0x18, 0x4f, 0x88, 0x24, 0xb1,
-	0x81, 0xbd, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x09, 0x01, 0x84, 0x34, 0x3a, 0x01, 0x00,
-	0x00,
+	0x54, 0x7d, 0x30, 0x2f, 0xa9, 0x34, 0x4d, 0x3f, 0x35, 0xb7, 0xa0, 0xa4, 0x12, 0x2a, 0x29, 0x5a,
+	0x96, 0x98, 0x93, 0x99, 0x92, 0x58, 0x82, 0x6a, 0xbc, 0x52, 0x3d, 0x17, 0xbf, 0x33, 0xcc, 0xf4,
+	0x30, 0xb0, 0xe1, 0x42, 0x6a, 0x5c, 0xdc, 0x29, 0xa9, 0xc5, 0x25, 0x99, 0x79, 0x89, 0x25, 0x99,
+	0xf9, 0x79, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x2c, 0x27, 0x3e, 0x4b, 0x30, 0x06, 0x21,
+	0x4b, 0x08, 0x89, 0x71, 0xb1, 0x15, 0xe7, 0x97, 0x16, 0x25, 0xa7, 0x4a, 0x30, 0x81, 0x94, 0x04,
+	0x41, 0x79, 0x42, 0x42, 0x5c, 0x2c, 0x25, 0x95, 0x05, 0xa9, 0x12, 0xcc, 0x60, 0x51, 0x30, 0x5b,
+	0x48, 0x82, 0x8b, 0x3d, 0xbf, 0x00, 0xa4, 0xab, 0x58, 0x82, 0x45, 0x81, 0x59, 0x83, 0x33, 0x08,
+	0xc6, 0x75, 0x72, 0xbe, 0xf1, 0x50, 0x8e, 0xe1, 0xc3, 0x43, 0x39, 0xc6, 0x1f, 0x0f, 0xe5, 0x18,
+	0x1a, 0x1e, 0xc9, 0x31, 0xae, 0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
+	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x46, 0x29, 0x26, 0xe6, 0x94, 0xe8, 0xe6, 0x17, 0xe3, 0x09, 0xae,
+	0x24, 0x36, 0xb0, 0x67, 0x8c, 0x01, 0x03, 0x00, 0x9d, 0x8d, 0xe8, 0xb8, 0x57, 0x01, 0x00, 0x00,
 }
 
 func (this *ContainerVolume) Equal(that interface{}) bool {
@@ -4,6 +4,7 @@
 package v0
 
 import (
+	_ "alt-os/api/validate/v0"
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
@@ -242,7 +243,41 @@ type ApiServeRequest struct {
 	// The number of seconds to timeout the API request.
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The path to the root directory of images.
-	RootDir              string   `protobuf:"bytes,4,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
+	RootDir string `protobuf:"bytes,4,opt,name=root_dir,json=rootDir,proto3" json:"root_dir,omitempty"`
+	// The path of a unix domain socket for the API server to listen on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,5,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The file permission bits to set on the API server socket when it is
+	// created. Defaults to 0600 if not set.
+	ApiSocketMode uint32 `protobuf:"varint,6,opt,name=api_socket_mode,json=apiSocketMode,proto3" json:"api_socket_mode,omitempty"`
+	// The path of a PEM certificate for the API server to present to clients.
+	// Enables TLS for the API server if set.
+	ApiServerCertFile string `protobuf:"bytes,7,opt,name=api_server_cert_file,json=apiServerCertFile,proto3" json:"api_server_cert_file,omitempty"`
+	// The path of the PEM private key of the API server certificate.
+	ApiServerKeyFile string `protobuf:"bytes,8,opt,name=api_server_key_file,json=apiServerKeyFile,proto3" json:"api_server_key_file,omitempty"`
+	// The path of a PEM file of CA certificates to verify clients with.
+	// Clients without a certificate signed by one of these are rejected.
+	ApiClientCaFile string `protobuf:"bytes,9,opt,name=api_client_ca_file,json=apiClientCaFile,proto3" json:"api_client_ca_file,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,10,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,11,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,12,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,13,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile string `protobuf:"bytes,14,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
+	// The path of the authorization policy file of the API server, which grants
+	// each caller a role. All callers may call every method if not set.
+	ApiAuthPolicyFile string `protobuf:"bytes,15,opt,name=api_auth_policy_file,json=apiAuthPolicyFile,proto3" json:"api_auth_policy_file,omitempty"`
+	// The port of an HTTP/JSON gateway to the API server to listen on at
+	// api_hostname, or at localhost if not set. No gateway is started if zero.
+	ApiHttpPort          uint32   `protobuf:"varint,16,opt,name=api_http_port,json=apiHttpPort,proto3" json:"api_http_port,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -308,6 +343,90 @@ func (m *ApiServeRequest) GetRootDir() string {
 	return ""
 }
 
+func (m *ApiServeRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiSocketMode() uint32 {
+	if m != nil {
+		return m.ApiSocketMode
+	}
+	return 0
+}
+
+func (m *ApiServeRequest) GetApiServerCertFile() string {
+	if m != nil {
+		return m.ApiServerCertFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiServerKeyFile() string {
+	if m != nil {
+		return m.ApiServerKeyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiClientCaFile() string {
+	if m != nil {
+		return m.ApiClientCaFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ApiServeRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiAuthPolicyFile() string {
+	if m != nil {
+		return m.ApiAuthPolicyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiHttpPort() uint32 {
+	if m != nil {
+		return m.ApiHttpPort
+	}
+	return 0
+}
+
 // ApiUnserveRequest specifies a VmImageService.Unserve call.
 type ApiUnserveRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -315,7 +434,24 @@ type ApiUnserveRequest struct {
 	// The port of the listening API server to operate on.
 	ApiPort uint32 `protobuf:"varint,2,opt,name=api_port,json=apiPort,proto3" json:"api_port,omitempty"`
 	// The number of seconds to timeout the API request.
-	ApiTimeout           uint32   `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,4,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,5,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,6,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,7,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,8,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,9,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -374,6 +510,48 @@ func (m *ApiUnserveRequest) GetApiTimeout() uint32 {
 	return 0
 }
 
+func (m *ApiUnserveRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ApiUnserveRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // CreateRequest specifies a VmImageService.Create call.
 type CreateRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -386,10 +564,27 @@ type CreateRequest struct {
 	// Not allowed if virtual_machines is set.
 	VirtualMachinesFile string `protobuf:"bytes,4,opt,name=virtual_machines_file,json=virtualMachinesFile,proto3" json:"virtual_machines_file,omitempty"`
 	// Objects defining the virtual_machines to create. Not allowed if virtual_machines_file is set.
-	VirtualMachines      []*VirtualMachine `protobuf:"bytes,5,rep,name=virtual_machines,json=virtualMachines,proto3" json:"virtual_machines,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	VirtualMachines []*VirtualMachine `protobuf:"bytes,5,rep,name=virtual_machines,json=virtualMachines,proto3" json:"virtual_machines,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,6,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,7,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,8,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,9,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,10,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,11,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateRequest) Reset()      { *m = CreateRequest{} }
@@ -459,6 +654,48 @@ func (m *CreateRequest) GetVirtualMachines() []*VirtualMachine {
 	return nil
 }
 
+func (m *CreateRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // VirtualMachine defines settings for a machine hosting OS containers.
 type VirtualMachine struct {
 	// The name of the subdirectory of the created virtual machine image
@@ -470,9 +707,9 @@ type VirtualMachine struct {
 	BiosImage string `protobuf:"bytes,3,opt,name=bios_image,json=biosImage,proto3" json:"bios_image,omitempty"`
 	// Path to the custom bios variables image to boot with.
 	VarsImage string `protobuf:"bytes,4,opt,name=vars_image,json=varsImage,proto3" json:"vars_image,omitempty"`
-	// Total memory of the machine in bytes.
+	// Total memory of the machine in bytes, at least 1 MiB.
 	Memory uint64 `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
-	// Number of processors available.
+	// Number of processors available, from 1 to 255.
 	Processors uint64 `protobuf:"varint,6,opt,name=processors,proto3" json:"processors,omitempty"`
 	// The type of cpu architecture.
 	ArchType ArchType `protobuf:"varint,7,opt,name=arch_type,json=archType,proto3,enum=os.machine.image.ArchType" json:"arch_type,omitempty"`
@@ -970,81 +1207,103 @@ func init() {
 }
 
 var fileDescriptor_2ca3fe20336776bf = []byte{
-	// 1182 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0x1a, 0x47,
-	0x14, 0xf6, 0x60, 0x6c, 0xc3, 0xb3, 0xc1, 0x9b, 0x49, 0xed, 0x6c, 0x48, 0x4b, 0x08, 0x69, 0x55,
-	0x64, 0x29, 0x10, 0xb9, 0x51, 0xa2, 0x2a, 0x97, 0x6e, 0x60, 0x6b, 0xa3, 0xd8, 0xac, 0x35, 0x2c,
-	0xa9, 0xd4, 0xcb, 0x6a, 0xbc, 0x8c, 0x61, 0x14, 0xd8, 0xd9, 0xee, 0x0e, 0xb4, 0xee, 0xa9, 0xf7,
-	0xfe, 0x91, 0xfe, 0x8b, 0x5e, 0x7b, 0xec, 0xb1, 0xc7, 0xc6, 0xd7, 0x1e, 0xd2, 0x63, 0x8f, 0xd5,
-	0xcc, 0x2e, 0xb6, 0xb1, 0xa1, 0xbd, 0xe5, 0x82, 0x76, 0xbe, 0xef, 0x7b, 0x6f, 0xde, 0x9b, 0xf7,
-	0x66, 0x78, 0xf0, 0x59, 0xf8, 0x76, 0xd0, 0xa0, 0x21, 0x6f, 0x88, 0xb8, 0x31, 0xa6, 0xfe, 0x90,
-	0x07, 0xac, 0xc1, 0xc7, 0x74, 0xc0, 0x1a, 0xd3, 0xa7, 0x0a, 0xaf, 0x87, 0x91, 0x90, 0x02, 0x1b,
-	0x22, 0xae, 0xa7, 0x74, 0x5d, 0xd3, 0xa5, 0x8f, 0x06, 0x62, 0x20, 0x34, 0xd9, 0x50, 0x5f, 0x89,
-	0xae, 0xf4, 0x60, 0x20, 0xc4, 0x60, 0xc4, 0x1a, 0x7a, 0x75, 0x3a, 0x39, 0x6b, 0xb0, 0x71, 0x28,
-	0xcf, 0x13, 0xb2, 0xfa, 0x33, 0x82, 0x6d, 0x2b, 0xe4, 0x5d, 0x16, 0x4d, 0x19, 0x61, 0xdf, 0x4d,
-	0x58, 0x2c, 0xf1, 0x23, 0xd8, 0xa2, 0x21, 0xf7, 0x86, 0x22, 0x96, 0x01, 0x1d, 0x33, 0x13, 0x55,
-	0x50, 0x2d, 0x4f, 0x36, 0x69, 0xc8, 0x0f, 0x53, 0x08, 0xdf, 0x87, 0x9c, 0x92, 0x84, 0x22, 0x92,
-	0x66, 0xa6, 0x82, 0x6a, 0x05, 0xb2, 0x41, 0x43, 0x7e, 0x22, 0x22, 0x89, 0x1f, 0x82, 0x52, 0x7a,
-	0x92, 0x8f, 0x99, 0x98, 0x48, 0x73, 0x55, 0xb3, 0x40, 0x43, 0xee, 0x26, 0x88, 0xb2, 0x8d, 0x84,
-	0x90, 0x5e, 0x9f, 0x47, 0x66, 0x56, 0xbb, 0xde, 0x50, 0xeb, 0x16, 0x8f, 0xaa, 0x11, 0xdc, 0xb1,
-	0x42, 0xde, 0x0b, 0xe2, 0x0f, 0x17, 0x4e, 0xf5, 0x3d, 0x82, 0x42, 0x33, 0x62, 0x54, 0x7e, 0xa8,
-	0xfc, 0xf7, 0x61, 0x67, 0xca, 0x23, 0x39, 0xa1, 0x23, 0x2f, 0x2d, 0x5f, 0xec, 0x9d, 0xf1, 0x11,
-	0x4b, 0x0f, 0xe3, 0x6e, 0x4a, 0x1e, 0xa7, 0xdc, 0xd7, 0x7c, 0xc4, 0xf0, 0x6b, 0x30, 0x6e, 0xda,
-	0x98, 0x6b, 0x95, 0xd5, 0xda, 0xe6, 0x7e, 0xa5, 0x7e, 0xb3, 0x0d, 0xea, 0x6f, 0xe6, 0x1c, 0x90,
-	0xed, 0x1b, 0x0e, 0xab, 0xef, 0xb3, 0x50, 0x9c, 0xd7, 0xe0, 0x07, 0x90, 0xd7, 0xb6, 0xba, 0x28,
-	0x49, 0xbe, 0x39, 0x0d, 0xb4, 0x78, 0xa4, 0x92, 0x65, 0x67, 0xdc, 0x0b, 0xa9, 0x1c, 0xea, 0x64,
-	0xf3, 0x64, 0x83, 0x9d, 0xf1, 0x13, 0x2a, 0x87, 0xf8, 0x13, 0x80, 0x53, 0x2e, 0x62, 0x4f, 0x6b,
-	0x75, 0xae, 0x79, 0x92, 0x57, 0x48, 0x5b, 0x01, 0x8a, 0x9e, 0xd2, 0x68, 0x46, 0x27, 0xf9, 0xe5,
-	0x15, 0x92, 0xd0, 0xbb, 0xb0, 0x3e, 0x66, 0x63, 0x11, 0x9d, 0x9b, 0x6b, 0x15, 0x54, 0xcb, 0x92,
-	0x74, 0x85, 0xcb, 0x00, 0x61, 0x24, 0x7c, 0x16, 0xc7, 0x22, 0x8a, 0xcd, 0x75, 0xcd, 0x5d, 0x43,
-	0xf0, 0x0b, 0xc8, 0xd3, 0xc8, 0x1f, 0x7a, 0xf2, 0x3c, 0x64, 0xe6, 0x46, 0x05, 0xd5, 0x8a, 0xfb,
-	0xa5, 0xdb, 0xc7, 0x60, 0x45, 0xfe, 0xd0, 0x3d, 0x0f, 0x19, 0xc9, 0xd1, 0xf4, 0x4b, 0xa5, 0xe9,
-	0x8f, 0x84, 0xff, 0xd6, 0x9b, 0x48, 0xdf, 0xcc, 0x55, 0x50, 0x2d, 0x47, 0x72, 0x1a, 0xe8, 0x49,
-	0x1f, 0x1f, 0xc3, 0x76, 0x28, 0x78, 0x20, 0x79, 0x30, 0xf0, 0xfa, 0x6c, 0xca, 0x7d, 0x66, 0xe6,
-	0xb5, 0xef, 0x4f, 0x6f, 0xfb, 0x3e, 0x49, 0x85, 0x2d, 0xad, 0xd3, 0xbb, 0x14, 0xc3, 0x39, 0x0c,
-	0x3f, 0x81, 0xb5, 0x29, 0xef, 0x33, 0x61, 0x42, 0x05, 0xd5, 0x36, 0xf7, 0xef, 0x2d, 0xaa, 0x53,
-	0x9f, 0x09, 0x92, 0xa8, 0x94, 0x9c, 0x4e, 0xfa, 0x5c, 0x98, 0x9b, 0xcb, 0xe4, 0x96, 0xa2, 0x49,
-	0xa2, 0xc2, 0x5f, 0xc2, 0x46, 0x2c, 0x45, 0xa4, 0x8e, 0x75, 0x4b, 0xf7, 0xc1, 0xc3, 0xdb, 0x06,
-	0xdd, 0x44, 0x90, 0xc4, 0x43, 0x66, 0x7a, 0x65, 0x1a, 0x30, 0xf9, 0xbd, 0x88, 0xde, 0x9a, 0x85,
-	0x65, 0xa6, 0x9d, 0x44, 0x30, 0x33, 0x4d, 0xf5, 0xf8, 0x39, 0xac, 0xc7, 0x2c, 0xe2, 0x74, 0x64,
-	0x16, 0xb5, 0x65, 0x79, 0xc1, 0xa6, 0x9a, 0x4f, 0x0d, 0x53, 0x75, 0xf5, 0x25, 0xac, 0xe9, 0x64,
-	0xaf, 0x55, 0x1c, 0xcd, 0x55, 0xbc, 0x04, 0xb9, 0x3e, 0x8f, 0xc3, 0x11, 0x3d, 0x8f, 0x75, 0x8b,
-	0x65, 0xc9, 0xe5, 0xba, 0xea, 0xc0, 0x9a, 0x4e, 0x1d, 0x3f, 0x86, 0x02, 0x0b, 0xe8, 0xe9, 0x88,
-	0x79, 0x62, 0x22, 0xc3, 0x89, 0xd4, 0x3e, 0x72, 0x64, 0x2b, 0x01, 0x1d, 0x8d, 0xa9, 0xcb, 0x9b,
-	0x8a, 0x78, 0xa0, 0x34, 0x19, 0xad, 0xd9, 0x4c, 0xb0, 0xb6, 0x82, 0xaa, 0xbf, 0x22, 0x28, 0xcc,
-	0x9d, 0x0d, 0x3e, 0x00, 0xf0, 0x45, 0x20, 0x23, 0x31, 0x1a, 0xb1, 0xa4, 0xff, 0x8b, 0xfb, 0x9f,
-	0x2f, 0x3d, 0xd0, 0xe6, 0xa5, 0x54, 0x17, 0xfe, 0x9a, 0x29, 0x7e, 0x01, 0x59, 0xdd, 0x94, 0x19,
-	0xed, 0xe2, 0xf1, 0xff, 0xd4, 0x44, 0x9b, 0x6b, 0x03, 0x8c, 0x21, 0x1b, 0xf3, 0x1f, 0x93, 0x2b,
-	0x94, 0x25, 0xfa, 0x1b, 0x9b, 0xb0, 0xd1, 0x3f, 0x0f, 0xe8, 0x98, 0xfb, 0xfa, 0xea, 0xe4, 0xc8,
-	0x6c, 0x59, 0x9d, 0x42, 0x61, 0xae, 0x42, 0xf8, 0x65, 0xba, 0xef, 0xd2, 0xd0, 0x53, 0xb9, 0x25,
-	0x25, 0xf5, 0x87, 0x63, 0x16, 0xc8, 0x6b, 0x7b, 0xef, 0xc2, 0xba, 0x7a, 0x22, 0xb8, 0x48, 0x0f,
-	0x2b, 0x5d, 0x61, 0x03, 0x56, 0xc7, 0xd4, 0x4f, 0x6f, 0xb5, 0xfa, 0xac, 0x06, 0xb0, 0x75, 0xbd,
-	0xbe, 0x2a, 0x6a, 0xfd, 0x04, 0x22, 0xfd, 0xc8, 0xe9, 0x6f, 0x15, 0x35, 0xed, 0xf7, 0x23, 0x16,
-	0xc7, 0x97, 0x2f, 0x63, 0xb2, 0xc4, 0x4f, 0xd3, 0x20, 0x57, 0x75, 0x90, 0x1f, 0x2f, 0xeb, 0x9d,
-	0xab, 0xc8, 0xf6, 0x5e, 0x42, 0x6e, 0x76, 0x8b, 0x71, 0x01, 0xf2, 0x16, 0x69, 0x1e, 0x7a, 0x1d,
-	0xa7, 0x63, 0x1b, 0x2b, 0xb8, 0x08, 0xa0, 0x97, 0xd6, 0x71, 0xeb, 0xf9, 0x33, 0x03, 0x61, 0x03,
-	0xb6, 0x92, 0xb5, 0xfa, 0x7d, 0xfe, 0xcc, 0xc8, 0xec, 0x39, 0x80, 0x6f, 0x5f, 0x53, 0x7c, 0x07,
-	0x0a, 0x27, 0x4e, 0xbb, 0xe3, 0xb6, 0x3b, 0x07, 0x33, 0x57, 0x18, 0x8a, 0x97, 0xd0, 0xb1, 0xd3,
-	0xeb, 0xda, 0x06, 0x9a, 0xc3, 0x5c, 0xa7, 0xd7, 0x3c, 0x34, 0x32, 0x7b, 0x63, 0xd8, 0x59, 0xd8,
-	0x01, 0xf8, 0x01, 0xdc, 0xeb, 0xba, 0x0e, 0xb1, 0x0e, 0x6c, 0xaf, 0xe9, 0x74, 0x5c, 0xe2, 0x1c,
-	0x1d, 0xd9, 0x64, 0xe6, 0x7d, 0x31, 0xd9, 0xb5, 0x5c, 0xcb, 0x40, 0xb8, 0x04, 0xbb, 0x0b, 0xc8,
-	0x5e, 0xf7, 0x95, 0x91, 0xd9, 0xfb, 0x01, 0xee, 0xdc, 0xea, 0x16, 0x7c, 0x0f, 0xee, 0xce, 0x0c,
-	0x5a, 0xf6, 0x9b, 0x76, 0xd3, 0x9e, 0x6d, 0xb3, 0x0b, 0xf8, 0x06, 0xd1, 0xed, 0xb6, 0x0c, 0xb4,
-	0x00, 0x3f, 0x6c, 0xb5, 0x8c, 0xcc, 0xf5, 0x9d, 0x53, 0xdc, 0x39, 0x71, 0xdb, 0x4d, 0xeb, 0xc8,
-	0x58, 0xdd, 0x0b, 0x60, 0x67, 0x61, 0xbf, 0xe0, 0xfb, 0xb0, 0xd3, 0xb1, 0x5d, 0xcf, 0x72, 0x5d,
-	0xab, 0x79, 0x78, 0x6c, 0x77, 0x5c, 0xaf, 0xd5, 0x26, 0x76, 0xd3, 0x35, 0x56, 0x94, 0xbf, 0x1b,
-	0xd4, 0x2b, 0xd2, 0x6e, 0x1d, 0xd8, 0x2a, 0x86, 0x32, 0x94, 0x6e, 0x70, 0x1d, 0xcb, 0xf5, 0x3a,
-	0xb6, 0xfb, 0x8d, 0x43, 0x5e, 0x1b, 0x99, 0xbd, 0x1e, 0xc0, 0x55, 0xe9, 0xf1, 0x36, 0x6c, 0x76,
-	0x6d, 0xd2, 0xb6, 0x8e, 0x66, 0xa9, 0x19, 0xb0, 0x95, 0x02, 0x5d, 0xb7, 0xd5, 0xee, 0x18, 0x48,
-	0x15, 0xf1, 0x0a, 0x71, 0x7a, 0xae, 0x91, 0x99, 0x87, 0x6c, 0x42, 0x8c, 0xd5, 0xfd, 0xbf, 0x10,
-	0x14, 0xdf, 0x8c, 0xf5, 0x5f, 0x8d, 0x9a, 0x6f, 0x92, 0x8b, 0x9e, 0x9b, 0x4d, 0x3b, 0xf8, 0xd1,
-	0x82, 0x27, 0x76, 0x7e, 0x12, 0x2a, 0xed, 0xd6, 0x93, 0xd9, 0xa9, 0x3e, 0x9b, 0x9d, 0xea, 0xb6,
-	0x9a, 0x9d, 0xaa, 0x2b, 0xf8, 0x35, 0xc0, 0xd5, 0xa4, 0x82, 0x1f, 0x2f, 0x74, 0x35, 0x3f, 0xc7,
-	0xfc, 0x87, 0xb3, 0x26, 0xac, 0x27, 0x13, 0x08, 0x5e, 0xf0, 0x14, 0xcf, 0xcd, 0x26, 0xcb, 0x9d,
-	0xbc, 0xfa, 0xea, 0x8f, 0x77, 0xe5, 0x95, 0xbf, 0xdf, 0x95, 0xd1, 0x3f, 0xef, 0xca, 0x2b, 0x3f,
-	0x5d, 0x94, 0xd1, 0x2f, 0x17, 0x65, 0xf4, 0xdb, 0x45, 0x19, 0xfd, 0x7e, 0x51, 0x46, 0x7f, 0x5e,
-	0x94, 0xd1, 0xb7, 0x65, 0x3a, 0x92, 0x4f, 0x44, 0xbc, 0x6c, 0xb4, 0x3c, 0x5d, 0xd7, 0x3e, 0xbf,
-	0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x05, 0x7f, 0xa9, 0x05, 0x80, 0x0a, 0x00, 0x00,
+	// 1525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcf, 0x6f, 0xdb, 0xc8,
+	0x15, 0xc7, 0x4d, 0x59, 0xb6, 0xa4, 0x27, 0x4b, 0xa6, 0x27, 0x6b, 0x87, 0x51, 0x5a, 0x6d, 0xa2,
+	0xec, 0x36, 0xa9, 0x8b, 0x48, 0x81, 0xbb, 0xc8, 0xa2, 0xcd, 0xa1, 0x4b, 0xfd, 0x68, 0x2c, 0xc4,
+	0x96, 0x0c, 0x8a, 0x4e, 0x81, 0x5e, 0x88, 0x09, 0x35, 0xb6, 0x06, 0xa6, 0x34, 0x2c, 0x39, 0x52,
+	0xab, 0x9e, 0xfa, 0x0f, 0xf4, 0xd2, 0x3f, 0xa1, 0xa7, 0xfe, 0x17, 0xbd, 0xee, 0xb1, 0xbd, 0xb5,
+	0x40, 0x0f, 0x1b, 0x1f, 0xdb, 0xcb, 0x02, 0xb9, 0xf4, 0xd6, 0x62, 0x1e, 0x49, 0xd9, 0x92, 0xa5,
+	0x5d, 0x9d, 0xe2, 0x83, 0x41, 0xbe, 0xf7, 0x79, 0x6f, 0x66, 0xde, 0xfb, 0xce, 0x88, 0x03, 0x9f,
+	0xfb, 0x57, 0x97, 0x35, 0xea, 0xf3, 0x9a, 0x08, 0x6b, 0x43, 0xea, 0x0e, 0xf8, 0x88, 0xd5, 0xf8,
+	0x90, 0x5e, 0xb2, 0xda, 0xe4, 0x85, 0xb2, 0x57, 0xfd, 0x40, 0x48, 0x41, 0x74, 0x11, 0x56, 0x63,
+	0x77, 0x15, 0xdd, 0xa5, 0x4f, 0x2e, 0xc5, 0xa5, 0x40, 0x67, 0x4d, 0x3d, 0x45, 0x5c, 0xe9, 0xe1,
+	0xa5, 0x10, 0x97, 0x1e, 0xab, 0xe1, 0xdb, 0xbb, 0xf1, 0x45, 0x8d, 0x0d, 0x7d, 0x39, 0x8d, 0x9d,
+	0xfb, 0x13, 0xea, 0xf1, 0x3e, 0x95, 0xf3, 0xb9, 0x2b, 0xff, 0x4b, 0xc3, 0xae, 0xe9, 0xf3, 0x1e,
+	0x0b, 0x26, 0xcc, 0x62, 0xbf, 0x19, 0xb3, 0x50, 0x92, 0xc7, 0xb0, 0x43, 0x7d, 0xee, 0x0c, 0x44,
+	0x28, 0x47, 0x74, 0xc8, 0x0c, 0xed, 0x91, 0xf6, 0x2c, 0x67, 0xe5, 0xa9, 0xcf, 0x8f, 0x63, 0x13,
+	0x79, 0x00, 0x59, 0x85, 0xf8, 0x22, 0x90, 0x46, 0xea, 0x91, 0xf6, 0xac, 0x60, 0x65, 0xa8, 0xcf,
+	0xcf, 0x44, 0x20, 0xc9, 0xa7, 0xa0, 0x48, 0x47, 0xf2, 0x21, 0x13, 0x63, 0x69, 0x6c, 0xa2, 0x17,
+	0xa8, 0xcf, 0xed, 0xc8, 0xa2, 0x62, 0x03, 0x21, 0xa4, 0xd3, 0xe7, 0x81, 0x91, 0xc6, 0xd4, 0x19,
+	0xf5, 0xde, 0xe4, 0x01, 0xf9, 0x21, 0x28, 0xd0, 0x09, 0x85, 0x7b, 0xc5, 0xa4, 0xb1, 0x85, 0xce,
+	0x1c, 0xf5, 0x79, 0x0f, 0x0d, 0xe4, 0x47, 0xb0, 0x7b, 0xe3, 0x76, 0x86, 0xa2, 0xcf, 0x8c, 0x6d,
+	0x4c, 0x5f, 0x98, 0x31, 0xa7, 0xa2, 0xcf, 0x48, 0x0d, 0x3e, 0x41, 0x4e, 0x2d, 0x2a, 0x70, 0x5c,
+	0x16, 0x48, 0xe7, 0x82, 0x7b, 0xcc, 0xc8, 0x60, 0xc2, 0x3d, 0x1a, 0xaf, 0x37, 0x68, 0xb0, 0x40,
+	0xfe, 0x92, 0x7b, 0x8c, 0x3c, 0x87, 0x7b, 0xb7, 0x02, 0xae, 0xd8, 0x34, 0xe2, 0xb3, 0xc8, 0xeb,
+	0x33, 0xfe, 0x0d, 0x9b, 0x22, 0xfe, 0x13, 0x20, 0x0a, 0x77, 0x3d, 0xce, 0x46, 0xd2, 0x71, 0x69,
+	0x44, 0xe7, 0x90, 0x56, 0x33, 0x6c, 0xa0, 0xa3, 0x41, 0x11, 0xfe, 0x3c, 0x9a, 0xb4, 0xf4, 0xc2,
+	0x19, 0x09, 0x48, 0xaa, 0x22, 0xdb, 0x5e, 0x18, 0x63, 0x3f, 0x86, 0xbd, 0x19, 0x36, 0x9b, 0x70,
+	0x1e, 0xc1, 0x62, 0x0c, 0x26, 0xb3, 0x7d, 0x0a, 0x7a, 0x82, 0xce, 0xa6, 0xba, 0x83, 0x64, 0x21,
+	0x22, 0x93, 0x79, 0xc6, 0xad, 0x08, 0x98, 0x0c, 0x38, 0x0b, 0x8d, 0xc2, 0xac, 0x15, 0x56, 0x64,
+	0x49, 0xd6, 0x4d, 0xc7, 0x72, 0xe0, 0x48, 0x71, 0xc5, 0x46, 0x51, 0xb2, 0xe2, 0x6c, 0xdd, 0xe6,
+	0x58, 0x0e, 0x6c, 0xe5, 0xc0, 0x7c, 0x71, 0x5d, 0x11, 0xf7, 0x85, 0xc7, 0xdd, 0x78, 0xf0, 0xdd,
+	0x59, 0x5d, 0x15, 0x7f, 0x86, 0x1e, 0x0c, 0xa8, 0x40, 0x01, 0x95, 0x24, 0xa5, 0x1f, 0x69, 0x45,
+	0xc7, 0x29, 0xa0, 0x94, 0xa4, 0xf4, 0x95, 0x5e, 0x2a, 0xff, 0x4a, 0xc1, 0x9e, 0xe9, 0xf3, 0xf3,
+	0x51, 0xf8, 0x11, 0x35, 0x38, 0x2f, 0xb4, 0xf4, 0xa2, 0xd0, 0x96, 0xf4, 0x6c, 0x6b, 0xdd, 0x9e,
+	0x6d, 0xaf, 0xdd, 0xb3, 0xcc, 0x1a, 0x3d, 0xcb, 0xae, 0xdb, 0xb3, 0xdc, 0xf2, 0x9e, 0x55, 0xfe,
+	0x98, 0x86, 0x42, 0x23, 0x60, 0x54, 0x7e, 0xac, 0xd2, 0x9e, 0xc0, 0xfe, 0x84, 0x07, 0x72, 0x4c,
+	0x3d, 0x27, 0x3e, 0xb4, 0xc2, 0x68, 0x86, 0x58, 0xe5, 0xba, 0xf1, 0xf5, 0x07, 0x43, 0xfb, 0xf7,
+	0x07, 0x43, 0x5f, 0x84, 0xac, 0x7b, 0xb1, 0xe5, 0x34, 0x36, 0x60, 0x39, 0x18, 0xdc, 0x01, 0x8d,
+	0xad, 0x47, 0x9b, 0xcf, 0xf2, 0x47, 0x8f, 0xaa, 0x8b, 0xc7, 0x62, 0xf5, 0xed, 0x5c, 0x82, 0xef,
+	0x18, 0x6a, 0x77, 0x61, 0xa8, 0x05, 0x3d, 0x6c, 0xaf, 0xa1, 0x87, 0xcc, 0xba, 0x7a, 0xc8, 0xae,
+	0xad, 0x87, 0xdc, 0x1a, 0x7a, 0x80, 0x75, 0xf5, 0x90, 0x5f, 0xa1, 0x87, 0x3f, 0x6f, 0x41, 0x71,
+	0xbe, 0x4e, 0xe4, 0x31, 0xe4, 0xb0, 0x7e, 0x78, 0x22, 0xa3, 0x1a, 0xea, 0x69, 0x55, 0x3a, 0x2b,
+	0x8b, 0x66, 0x75, 0x30, 0x3f, 0x80, 0x2c, 0xbb, 0xe0, 0x8e, 0x4f, 0xe5, 0x00, 0x05, 0x91, 0xb3,
+	0x32, 0xec, 0x82, 0x9f, 0x51, 0x39, 0x50, 0xa5, 0x7b, 0xc7, 0x45, 0xe8, 0x20, 0x8b, 0x7a, 0xc8,
+	0x59, 0x39, 0x65, 0x69, 0x2b, 0x83, 0x72, 0x4f, 0x68, 0x90, 0xb8, 0xe3, 0x9d, 0xa6, 0x2c, 0x91,
+	0xfb, 0x29, 0x6c, 0x0f, 0xd9, 0x50, 0x04, 0x53, 0xdc, 0x60, 0xe9, 0xfa, 0xae, 0x1a, 0xf8, 0xef,
+	0x1f, 0x8c, 0x0d, 0xfc, 0x7b, 0x61, 0x5a, 0xb1, 0x9b, 0xfc, 0x1c, 0xc0, 0x0f, 0x84, 0xcb, 0xc2,
+	0x50, 0x04, 0x21, 0x76, 0x28, 0x5d, 0x2f, 0xcd, 0xc1, 0xdf, 0xfe, 0xe2, 0x9f, 0xf1, 0xe3, 0x37,
+	0xe2, 0x2b, 0xeb, 0x16, 0x4d, 0xbe, 0x84, 0x1c, 0x0d, 0xdc, 0x81, 0x23, 0xa7, 0x7e, 0xd4, 0xb8,
+	0xe2, 0x51, 0xe9, 0xae, 0x7a, 0xcc, 0xc0, 0x1d, 0xd8, 0x53, 0x9f, 0x59, 0x59, 0x1a, 0x3f, 0x91,
+	0x87, 0x90, 0x73, 0x3d, 0xe1, 0x5e, 0x39, 0x63, 0xe9, 0x62, 0x23, 0xb3, 0x56, 0x16, 0x0d, 0xe7,
+	0xd2, 0x25, 0xa7, 0xb0, 0xeb, 0x0b, 0x3e, 0x92, 0x7c, 0x74, 0xe9, 0xf4, 0xd9, 0x84, 0xbb, 0x51,
+	0x07, 0x8b, 0x47, 0x9f, 0xdd, 0xcd, 0x7d, 0x16, 0x83, 0x4d, 0xe4, 0x70, 0x94, 0xa2, 0x3f, 0x67,
+	0x23, 0xcf, 0x61, 0x6b, 0xc2, 0xfb, 0x4c, 0x60, 0x8b, 0xf3, 0x47, 0xf7, 0x97, 0xc9, 0xbb, 0xcf,
+	0x84, 0x15, 0x51, 0x0a, 0xa7, 0xe3, 0x3e, 0x17, 0x46, 0x7e, 0x15, 0x6e, 0x2a, 0xb7, 0x15, 0x51,
+	0xe4, 0x67, 0x90, 0x09, 0xa5, 0x08, 0x54, 0x0f, 0x76, 0x70, 0xfb, 0x7c, 0x7a, 0x37, 0xa0, 0x17,
+	0x01, 0xd1, 0x7c, 0xac, 0x84, 0x57, 0xa1, 0x23, 0x26, 0x7f, 0x2b, 0x82, 0x2b, 0xa3, 0xb0, 0x2a,
+	0xb4, 0x13, 0x01, 0x49, 0x68, 0xcc, 0x93, 0x97, 0xb0, 0x1d, 0xb2, 0x80, 0x53, 0xcf, 0x28, 0x62,
+	0x64, 0x79, 0xc9, 0xa0, 0xe8, 0x8f, 0x03, 0x63, 0xba, 0xf2, 0x0a, 0xb6, 0x70, 0xb1, 0xe4, 0x60,
+	0x26, 0x0f, 0xa5, 0xcb, 0xf4, 0x4c, 0x0d, 0x25, 0xc8, 0xf6, 0x79, 0xe8, 0x7b, 0x74, 0x1a, 0xa2,
+	0x1e, 0xd3, 0xd6, 0xec, 0xbd, 0xd2, 0x85, 0x2d, 0x5c, 0x3a, 0x79, 0x02, 0x05, 0x36, 0xa2, 0xef,
+	0x3c, 0xe6, 0x88, 0xb1, 0xf4, 0xc7, 0x12, 0x73, 0x64, 0xad, 0x9d, 0xc8, 0xd8, 0x45, 0x9b, 0x3a,
+	0x0d, 0x63, 0x88, 0x8f, 0x14, 0x93, 0x42, 0x26, 0x1f, 0xd9, 0xda, 0xca, 0x54, 0xf9, 0xab, 0x06,
+	0x85, 0xb9, 0xda, 0x90, 0xd7, 0x00, 0xae, 0x18, 0xc9, 0x40, 0x78, 0x1e, 0x8b, 0xb6, 0x4c, 0xf1,
+	0xe8, 0xe9, 0xca, 0x82, 0x36, 0x66, 0x28, 0x36, 0xfe, 0x56, 0x28, 0xf9, 0x12, 0xd2, 0x28, 0xca,
+	0x14, 0xa6, 0x78, 0xf2, 0x3d, 0x3d, 0xc1, 0x70, 0x0c, 0x20, 0x04, 0xd2, 0x21, 0xff, 0x7d, 0xb4,
+	0xdf, 0xd2, 0x16, 0x3e, 0x13, 0x03, 0x32, 0xfd, 0xe9, 0x88, 0x0e, 0xb9, 0x8b, 0xfb, 0x2c, 0x6b,
+	0x25, 0xaf, 0x95, 0x09, 0x14, 0xe6, 0x3a, 0x44, 0x5e, 0xc5, 0xe3, 0xae, 0x9c, 0x7a, 0x8c, 0x9b,
+	0x52, 0x52, 0x77, 0x30, 0x64, 0x23, 0x79, 0x6b, 0xec, 0x03, 0xd8, 0x56, 0xe7, 0x27, 0x17, 0x71,
+	0xb1, 0xe2, 0x37, 0xa2, 0xc3, 0xe6, 0x90, 0xba, 0xf1, 0x11, 0xa0, 0x1e, 0x2b, 0x7f, 0xd2, 0x60,
+	0xe7, 0x76, 0x83, 0xc9, 0x67, 0x90, 0xc6, 0x1f, 0x15, 0x35, 0x6e, 0xa1, 0xae, 0xc7, 0x07, 0x74,
+	0xd6, 0x13, 0x2e, 0x95, 0x5c, 0x8c, 0x2c, 0xf4, 0x92, 0x43, 0xc8, 0xd0, 0x7e, 0x3f, 0x60, 0x61,
+	0xd4, 0xdc, 0x65, 0x60, 0x02, 0x90, 0x17, 0xf1, 0x4a, 0x36, 0x71, 0x25, 0x3f, 0x58, 0x25, 0xb0,
+	0x9b, 0xe9, 0x1f, 0xbe, 0x82, 0x6c, 0xb2, 0xd5, 0x49, 0x01, 0x72, 0xa6, 0xd5, 0x38, 0x76, 0x3a,
+	0xdd, 0x4e, 0x4b, 0xdf, 0x20, 0x45, 0x00, 0x7c, 0x35, 0x4f, 0x9b, 0x2f, 0xbf, 0xd0, 0x35, 0xa2,
+	0xc3, 0x4e, 0xf4, 0xae, 0xfe, 0xbf, 0xfc, 0x42, 0x4f, 0x1d, 0x76, 0x81, 0xdc, 0xdd, 0xcb, 0x64,
+	0x0f, 0x0a, 0x67, 0xdd, 0x76, 0xc7, 0x6e, 0x77, 0x5e, 0x27, 0xa9, 0x08, 0x14, 0x67, 0xa6, 0xd3,
+	0xee, 0x79, 0xaf, 0xa5, 0x6b, 0x73, 0x36, 0xbb, 0x7b, 0xde, 0x38, 0xd6, 0x53, 0x87, 0x43, 0xd8,
+	0x5f, 0x2a, 0x13, 0xf2, 0x10, 0xee, 0xf7, 0xec, 0xae, 0x65, 0xbe, 0x6e, 0x39, 0x8d, 0x6e, 0xc7,
+	0xb6, 0xba, 0x27, 0x27, 0x2d, 0x2b, 0xc9, 0xbe, 0xdc, 0xd9, 0x33, 0x6d, 0x53, 0xd7, 0x48, 0x09,
+	0x0e, 0x96, 0x38, 0xcf, 0x7b, 0x75, 0x3d, 0x75, 0xf8, 0x3b, 0xd8, 0xbb, 0x23, 0x29, 0x72, 0x1f,
+	0xee, 0x25, 0x01, 0xcd, 0xd6, 0xdb, 0x76, 0xa3, 0x95, 0x0c, 0x73, 0x00, 0x64, 0xc1, 0xd1, 0xeb,
+	0x35, 0x75, 0x6d, 0x89, 0xfd, 0xb8, 0xd9, 0xd4, 0x53, 0xb7, 0x47, 0x8e, 0xed, 0xdd, 0x33, 0xbb,
+	0xdd, 0x30, 0x4f, 0xf4, 0xcd, 0xc3, 0x11, 0xec, 0x2f, 0x15, 0x15, 0x79, 0x00, 0xfb, 0x9d, 0x96,
+	0xed, 0x98, 0xb6, 0x6d, 0x36, 0x8e, 0x4f, 0x5b, 0x1d, 0xdb, 0x69, 0xb6, 0xad, 0x56, 0xc3, 0xd6,
+	0x37, 0x54, 0xbe, 0x05, 0x57, 0xdd, 0x6a, 0x37, 0x5f, 0xb7, 0xd4, 0x1c, 0xca, 0x50, 0x5a, 0xf0,
+	0x75, 0x4c, 0xdb, 0xe9, 0xb4, 0xec, 0x5f, 0x75, 0xad, 0x37, 0x7a, 0xea, 0xf0, 0x1c, 0xe0, 0xa6,
+	0xf5, 0x64, 0x17, 0xf2, 0xbd, 0x96, 0xd5, 0x36, 0x4f, 0x92, 0xa5, 0xe9, 0xb0, 0x13, 0x1b, 0x7a,
+	0x76, 0xb3, 0xdd, 0xd1, 0x35, 0xd5, 0xc4, 0x1b, 0x4b, 0xf7, 0xdc, 0xd6, 0x53, 0xf3, 0xa6, 0x96,
+	0x65, 0xe9, 0x9b, 0x47, 0xff, 0xd1, 0xa0, 0xf8, 0x76, 0x88, 0x3f, 0x5e, 0xea, 0x52, 0x10, 0x9d,
+	0x06, 0xd9, 0xe4, 0x0a, 0x45, 0x1e, 0x2f, 0x39, 0x87, 0xe7, 0xaf, 0x57, 0xa5, 0x83, 0x6a, 0x74,
+	0x4f, 0xab, 0x26, 0xf7, 0xb4, 0x6a, 0x4b, 0xdd, 0xd3, 0x2a, 0x1b, 0xe4, 0x0d, 0xc0, 0xcd, 0x97,
+	0x30, 0x79, 0xb2, 0x34, 0xd5, 0xfc, 0x77, 0xf2, 0x77, 0x24, 0x6b, 0xc0, 0x76, 0xf4, 0xdd, 0x47,
+	0x96, 0x9c, 0xd7, 0x73, 0x5f, 0x84, 0xab, 0x93, 0xd4, 0xbf, 0xfa, 0xc7, 0xfb, 0xf2, 0xc6, 0xb7,
+	0xef, 0xcb, 0xda, 0x7f, 0xdf, 0x97, 0x37, 0xfe, 0x70, 0x5d, 0xd6, 0xfe, 0x72, 0x5d, 0xd6, 0xbe,
+	0xbe, 0x2e, 0x6b, 0x7f, 0xbb, 0x2e, 0x6b, 0xdf, 0x5c, 0x97, 0xb5, 0x5f, 0x97, 0xa9, 0x27, 0x9f,
+	0x8b, 0x70, 0xd5, 0x35, 0xf6, 0xdd, 0x36, 0xe6, 0xfc, 0xe9, 0xff, 0x07, 0x00, 0x5f, 0xbf, 0xf9,
+	0x90, 0xec, 0x0e, 0x00, 0x00,
 }
 
 func (this *ApiServeRequest) Equal(that interface{}) bool {
@@ -1078,6 +1337,42 @@ func (this *ApiServeRequest) Equal(that interface{}) bool {
 	if this.RootDir != that1.RootDir {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiSocketMode != that1.ApiSocketMode {
+		return false
+	}
+	if this.ApiServerCertFile != that1.ApiServerCertFile {
+		return false
+	}
+	if this.ApiServerKeyFile != that1.ApiServerKeyFile {
+		return false
+	}
+	if this.ApiClientCaFile != that1.ApiClientCaFile {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
+	if this.ApiAuthPolicyFile != that1.ApiAuthPolicyFile {
+		return false
+	}
+	if this.ApiHttpPort != that1.ApiHttpPort {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1111,6 +1406,24 @@ func (this *ApiUnserveRequest) Equal(that interface{}) bool {
 	if this.ApiTimeout != that1.ApiTimeout {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1155,6 +1468,24 @@ func (this *CreateRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1407,12 +1738,24 @@ func (this *ApiServeRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 20)
 	s = append(s, "&v0.ApiServeRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "RootDir: "+fmt.Sprintf("%#v", this.RootDir)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiSocketMode: "+fmt.Sprintf("%#v", this.ApiSocketMode)+",\n")
+	s = append(s, "ApiServerCertFile: "+fmt.Sprintf("%#v", this.ApiServerCertFile)+",\n")
+	s = append(s, "ApiServerKeyFile: "+fmt.Sprintf("%#v", this.ApiServerKeyFile)+",\n")
+	s = append(s, "ApiClientCaFile: "+fmt.Sprintf("%#v", this.ApiClientCaFile)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
+	s = append(s, "ApiAuthPolicyFile: "+fmt.Sprintf("%#v", this.ApiAuthPolicyFile)+",\n")
+	s = append(s, "ApiHttpPort: "+fmt.Sprintf("%#v", this.ApiHttpPort)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1423,11 +1766,17 @@ func (this *ApiUnserveRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 13)
 	s = append(s, "&v0.ApiUnserveRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1438,7 +1787,7 @@ func (this *CreateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 15)
 	s = append(s, "&v0.CreateRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
@@ -1447,6 +1796,12 @@ func (this *CreateRequest) GoString() string {
 	if this.VirtualMachines != nil {
 		s = append(s, "VirtualMachines: "+fmt.Sprintf("%#v", this.VirtualMachines)+",\n")
 	}
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1754,6 +2109,86 @@ func (m *ApiServeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ApiHttpPort != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiHttpPort))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ApiAuthPolicyFile) > 0 {
+		i -= len(m.ApiAuthPolicyFile)
+		copy(dAtA[i:], m.ApiAuthPolicyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthPolicyFile)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ApiClientCaFile) > 0 {
+		i -= len(m.ApiClientCaFile)
+		copy(dAtA[i:], m.ApiClientCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiClientCaFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiServerKeyFile) > 0 {
+		i -= len(m.ApiServerKeyFile)
+		copy(dAtA[i:], m.ApiServerKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiServerKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiServerCertFile) > 0 {
+		i -= len(m.ApiServerCertFile)
+		copy(dAtA[i:], m.ApiServerCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiServerCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ApiSocketMode != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiSocketMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.RootDir) > 0 {
 		i -= len(m.RootDir)
 		copy(dAtA[i:], m.RootDir)
@@ -1805,6 +2240,46 @@ func (m *ApiUnserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.ApiTimeout != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.ApiTimeout))
 		i--
@@ -1849,6 +2324,46 @@ func (m *CreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.VirtualMachines) > 0 {
 		for iNdEx := len(m.VirtualMachines) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2299,33 +2814,101 @@ func (m *ApiServeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
-
-func (m *ApiUnserveRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ApiSocketMode != 0 {
+		n += 1 + sovApi(uint64(m.ApiSocketMode))
 	}
-	var l int
-	_ = l
-	l = len(m.ApiHostname)
+	l = len(m.ApiServerCertFile)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.ApiPort != 0 {
-		n += 1 + sovApi(uint64(m.ApiPort))
+	l = len(m.ApiServerKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.ApiTimeout != 0 {
-		n += 1 + sovApi(uint64(m.ApiTimeout))
+	l = len(m.ApiClientCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
 	}
-	return n
-}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiAuthPolicyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiHttpPort != 0 {
+		n += 2 + sovApi(uint64(m.ApiHttpPort))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApiUnserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ApiHostname)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiPort != 0 {
+		n += 1 + sovApi(uint64(m.ApiPort))
+	}
+	if m.ApiTimeout != 0 {
+		n += 1 + sovApi(uint64(m.ApiTimeout))
+	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
 
 func (m *CreateRequest) Size() (n int) {
 	if m == nil {
@@ -2353,6 +2936,29 @@ func (m *CreateRequest) Size() (n int) {
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2546,6 +3152,18 @@ func (this *ApiServeRequest) String() string {
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`RootDir:` + fmt.Sprintf("%v", this.RootDir) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiSocketMode:` + fmt.Sprintf("%v", this.ApiSocketMode) + `,`,
+		`ApiServerCertFile:` + fmt.Sprintf("%v", this.ApiServerCertFile) + `,`,
+		`ApiServerKeyFile:` + fmt.Sprintf("%v", this.ApiServerKeyFile) + `,`,
+		`ApiClientCaFile:` + fmt.Sprintf("%v", this.ApiClientCaFile) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
+		`ApiAuthPolicyFile:` + fmt.Sprintf("%v", this.ApiAuthPolicyFile) + `,`,
+		`ApiHttpPort:` + fmt.Sprintf("%v", this.ApiHttpPort) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2559,6 +3177,12 @@ func (this *ApiUnserveRequest) String() string {
 		`ApiHostname:` + fmt.Sprintf("%v", this.ApiHostname) + `,`,
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2579,6 +3203,12 @@ func (this *CreateRequest) String() string {
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`VirtualMachinesFile:` + fmt.Sprintf("%v", this.VirtualMachinesFile) + `,`,
 		`VirtualMachines:` + repeatedStringForVirtualMachines + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2826,60 +3456,9 @@ func (m *ApiServeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.RootDir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApiUnserveRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApiUnserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2907,13 +3486,13 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocketMode", wireType)
 			}
-			m.ApiPort = 0
+			m.ApiSocketMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2923,16 +3502,16 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				m.ApiSocketMode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiServerCertFile", wireType)
 			}
-			m.ApiTimeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2942,65 +3521,27 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ApiServerCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiServerKeyFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3028,13 +3569,13 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiServerKeyFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiClientCaFile", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3044,16 +3585,29 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ApiTimeout = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiClientCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3063,14 +3617,27 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualMachinesFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3098,13 +3665,13 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VirtualMachinesFile = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualMachines", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3114,15 +3681,602 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthPolicyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthPolicyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHttpPort", wireType)
+			}
+			m.ApiHttpPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiHttpPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiUnserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiUnserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualMachinesFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualMachinesFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualMachines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
@@ -3134,6 +4288,185 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -4,6 +4,7 @@
 package v0
 
 import (
+	_ "alt-os/api/validate/v0"
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
@@ -129,8 +130,42 @@ type ApiServeRequest struct {
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The root directory of images to load from.
 	ImageDir string `protobuf:"bytes,4,opt,name=image_dir,json=imageDir,proto3" json:"image_dir,omitempty"`
-	// The maximum number of virtual machines to allow.
-	MaxMachines          int64    `protobuf:"varint,5,opt,name=max_machines,json=maxMachines,proto3" json:"max_machines,omitempty"`
+	// The maximum number of virtual machines to allow, at least 1.
+	MaxMachines int64 `protobuf:"varint,5,opt,name=max_machines,json=maxMachines,proto3" json:"max_machines,omitempty"`
+	// The path of a unix domain socket for the API server to listen on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,6,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The file permission bits to set on the API server socket when it is
+	// created. Defaults to 0600 if not set.
+	ApiSocketMode uint32 `protobuf:"varint,7,opt,name=api_socket_mode,json=apiSocketMode,proto3" json:"api_socket_mode,omitempty"`
+	// The path of a PEM certificate for the API server to present to clients.
+	// Enables TLS for the API server if set.
+	ApiServerCertFile string `protobuf:"bytes,8,opt,name=api_server_cert_file,json=apiServerCertFile,proto3" json:"api_server_cert_file,omitempty"`
+	// The path of the PEM private key of the API server certificate.
+	ApiServerKeyFile string `protobuf:"bytes,9,opt,name=api_server_key_file,json=apiServerKeyFile,proto3" json:"api_server_key_file,omitempty"`
+	// The path of a PEM file of CA certificates to verify clients with.
+	// Clients without a certificate signed by one of these are rejected.
+	ApiClientCaFile string `protobuf:"bytes,10,opt,name=api_client_ca_file,json=apiClientCaFile,proto3" json:"api_client_ca_file,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,11,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,12,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,13,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,14,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile string `protobuf:"bytes,15,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
+	// The path of the authorization policy file of the API server, which grants
+	// each caller a role. All callers may call every method if not set.
+	ApiAuthPolicyFile string `protobuf:"bytes,16,opt,name=api_auth_policy_file,json=apiAuthPolicyFile,proto3" json:"api_auth_policy_file,omitempty"`
+	// The port of an HTTP/JSON gateway to the API server to listen on at
+	// api_hostname, or at localhost if not set. No gateway is started if zero.
+	ApiHttpPort          uint32   `protobuf:"varint,17,opt,name=api_http_port,json=apiHttpPort,proto3" json:"api_http_port,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -203,6 +238,90 @@ func (m *ApiServeRequest) GetMaxMachines() int64 {
 	return 0
 }
 
+func (m *ApiServeRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiSocketMode() uint32 {
+	if m != nil {
+		return m.ApiSocketMode
+	}
+	return 0
+}
+
+func (m *ApiServeRequest) GetApiServerCertFile() string {
+	if m != nil {
+		return m.ApiServerCertFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiServerKeyFile() string {
+	if m != nil {
+		return m.ApiServerKeyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiClientCaFile() string {
+	if m != nil {
+		return m.ApiClientCaFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ApiServeRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiAuthPolicyFile() string {
+	if m != nil {
+		return m.ApiAuthPolicyFile
+	}
+	return ""
+}
+
+func (m *ApiServeRequest) GetApiHttpPort() uint32 {
+	if m != nil {
+		return m.ApiHttpPort
+	}
+	return 0
+}
+
 // ApiUnserveRequest specifies a VmRuntimeService.Unserve call.
 type ApiUnserveRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -212,7 +331,24 @@ type ApiUnserveRequest struct {
 	// The number of seconds to timeout the API request.
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The number of seconds to timeout exit cleanup routines.
-	CleanupTimeout       uint32   `protobuf:"varint,4,opt,name=cleanup_timeout,json=cleanupTimeout,proto3" json:"cleanup_timeout,omitempty"`
+	CleanupTimeout uint32 `protobuf:"varint,4,opt,name=cleanup_timeout,json=cleanupTimeout,proto3" json:"cleanup_timeout,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,5,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,6,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,7,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,8,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,9,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,10,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -278,6 +414,48 @@ func (m *ApiUnserveRequest) GetCleanupTimeout() uint32 {
 	return 0
 }
 
+func (m *ApiUnserveRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ApiUnserveRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ApiUnserveRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // ListRequest specifies a VmRuntimeService.List call.
 type ListRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -285,7 +463,24 @@ type ListRequest struct {
 	// The port of the listening API server to operate on.
 	ApiPort uint32 `protobuf:"varint,2,opt,name=api_port,json=apiPort,proto3" json:"api_port,omitempty"`
 	// The number of seconds to timeout the API request.
-	ApiTimeout           uint32   `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,4,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,5,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,6,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,7,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,8,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,9,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -344,6 +539,48 @@ func (m *ListRequest) GetApiTimeout() uint32 {
 	return 0
 }
 
+func (m *ListRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *ListRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *ListRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *ListRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *ListRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *ListRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // ListResponse returns the result of a VmRuntimeService.List call.
 type ListResponse struct {
 	// The hostname of the listening API server to operate on.
@@ -428,7 +665,24 @@ type QueryStateRequest struct {
 	// The number of seconds to timeout the API request.
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The unique id of the virtual machine.
-	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,5,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,6,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,7,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,8,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,9,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,10,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -494,6 +748,48 @@ func (m *QueryStateRequest) GetId() string {
 	return ""
 }
 
+func (m *QueryStateRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *QueryStateRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *QueryStateRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *QueryStateRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *QueryStateRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *QueryStateRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // QueryStateResponse returns the result of a VmRuntimeService.QueryState call.
 type QueryStateResponse struct {
 	// The request used to create the virtual machine's runtime.
@@ -571,7 +867,24 @@ type CreateRequest struct {
 	// The unique id of the virtual machine.
 	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
 	// The virtual machine's image directory.
-	Image                string   `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Image string `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,6,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,7,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,8,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,9,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,10,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,11,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -644,6 +957,48 @@ func (m *CreateRequest) GetImage() string {
 	return ""
 }
 
+func (m *CreateRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // StartRequest specifies a VmRuntimeService.Start call.
 type StartRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -653,7 +1008,24 @@ type StartRequest struct {
 	// The number of seconds to timeout the API request.
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The unique id of the virtual machine.
-	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,5,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,6,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,7,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,8,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,9,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,10,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -719,6 +1091,48 @@ func (m *StartRequest) GetId() string {
 	return ""
 }
 
+func (m *StartRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *StartRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *StartRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *StartRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *StartRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *StartRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // KillRequest specifies a VmRuntimeService.Kill call.
 type KillRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -730,10 +1144,27 @@ type KillRequest struct {
 	// The unique id of the virtual machine.
 	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
 	// The kill signal to send.
-	Signal               KillSignal `protobuf:"varint,5,opt,name=signal,proto3,enum=os.machine.runtime.KillSignal" json:"signal,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Signal KillSignal `protobuf:"varint,5,opt,name=signal,proto3,enum=os.machine.runtime.KillSignal" json:"signal,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,6,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,7,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,8,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,9,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,10,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,11,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *KillRequest) Reset()      { *m = KillRequest{} }
@@ -803,6 +1234,48 @@ func (m *KillRequest) GetSignal() KillSignal {
 	return KillSignal_SIGNONE
 }
 
+func (m *KillRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *KillRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *KillRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *KillRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *KillRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *KillRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 // DeleteRequest specifies a VmRuntimeService.Delete call.
 type DeleteRequest struct {
 	// The hostname of the listening API server to operate on.
@@ -812,7 +1285,24 @@ type DeleteRequest struct {
 	// The number of seconds to timeout the API request.
 	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
 	// The unique id of the virtual machine.
-	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,5,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,6,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,7,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,8,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,9,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,10,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -878,20 +1368,79 @@ func (m *DeleteRequest) GetId() string {
 	return ""
 }
 
-// DeployRequest specifies a HwRuntimeService.Deploy call.
-type DeployRequest struct {
-	// The hostname of the listening API server to operate on.
-	ApiHostname string `protobuf:"bytes,1,opt,name=api_hostname,json=apiHostname,proto3" json:"api_hostname,omitempty"`
-	// The port of the listening API server to operate on.
-	ApiPort uint32 `protobuf:"varint,2,opt,name=api_port,json=apiPort,proto3" json:"api_port,omitempty"`
-	// The number of seconds to timeout the API request.
-	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
-	// The unique id of the hardware machine.
+func (m *DeleteRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *DeleteRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
+// DeployRequest specifies a HwRuntimeService.Deploy call.
+type DeployRequest struct {
+	// The hostname of the listening API server to operate on.
+	ApiHostname string `protobuf:"bytes,1,opt,name=api_hostname,json=apiHostname,proto3" json:"api_hostname,omitempty"`
+	// The port of the listening API server to operate on.
+	ApiPort uint32 `protobuf:"varint,2,opt,name=api_port,json=apiPort,proto3" json:"api_port,omitempty"`
+	// The number of seconds to timeout the API request.
+	ApiTimeout uint32 `protobuf:"varint,3,opt,name=api_timeout,json=apiTimeout,proto3" json:"api_timeout,omitempty"`
+	// The unique id of the hardware machine.
 	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
 	// The hardware device definition filename, if present.
 	HwDefFile string `protobuf:"bytes,5,opt,name=hw_def_file,json=hwDefFile,proto3" json:"hw_def_file,omitempty"`
 	// The deployer machine device to use for connecting to the target device serial port.
-	SerialDevice         string   `protobuf:"bytes,6,opt,name=serial_device,json=serialDevice,proto3" json:"serial_device,omitempty"`
+	SerialDevice string `protobuf:"bytes,6,opt,name=serial_device,json=serialDevice,proto3" json:"serial_device,omitempty"`
+	// The path of the unix domain socket of the listening API server to operate on.
+	// Overrides api_hostname and api_port if set.
+	ApiSocket string `protobuf:"bytes,7,opt,name=api_socket,json=apiSocket,proto3" json:"api_socket,omitempty"`
+	// The path of a PEM file of CA certificates to verify the API server with.
+	// Enables TLS for the client connection if set.
+	ApiTlsCaFile string `protobuf:"bytes,8,opt,name=api_tls_ca_file,json=apiTlsCaFile,proto3" json:"api_tls_ca_file,omitempty"`
+	// The path of a PEM client certificate to present to the API server.
+	// Enables TLS for the client connection if set.
+	ApiTlsCertFile string `protobuf:"bytes,9,opt,name=api_tls_cert_file,json=apiTlsCertFile,proto3" json:"api_tls_cert_file,omitempty"`
+	// The path of the PEM private key of the client certificate.
+	ApiTlsKeyFile string `protobuf:"bytes,10,opt,name=api_tls_key_file,json=apiTlsKeyFile,proto3" json:"api_tls_key_file,omitempty"`
+	// The number of times to retry the API request if the server is unavailable
+	// or a read-only request times out, with exponential backoff between attempts.
+	ApiRetries uint32 `protobuf:"varint,11,opt,name=api_retries,json=apiRetries,proto3" json:"api_retries,omitempty"`
+	// The path of a file containing the bearer token to identify the caller to
+	// the API server with.
+	ApiAuthTokenFile     string   `protobuf:"bytes,12,opt,name=api_auth_token_file,json=apiAuthTokenFile,proto3" json:"api_auth_token_file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -971,6 +1520,48 @@ func (m *DeployRequest) GetSerialDevice() string {
 	return ""
 }
 
+func (m *DeployRequest) GetApiSocket() string {
+	if m != nil {
+		return m.ApiSocket
+	}
+	return ""
+}
+
+func (m *DeployRequest) GetApiTlsCaFile() string {
+	if m != nil {
+		return m.ApiTlsCaFile
+	}
+	return ""
+}
+
+func (m *DeployRequest) GetApiTlsCertFile() string {
+	if m != nil {
+		return m.ApiTlsCertFile
+	}
+	return ""
+}
+
+func (m *DeployRequest) GetApiTlsKeyFile() string {
+	if m != nil {
+		return m.ApiTlsKeyFile
+	}
+	return ""
+}
+
+func (m *DeployRequest) GetApiRetries() uint32 {
+	if m != nil {
+		return m.ApiRetries
+	}
+	return 0
+}
+
+func (m *DeployRequest) GetApiAuthTokenFile() string {
+	if m != nil {
+		return m.ApiAuthTokenFile
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("os.machine.runtime.VirtualMachineStatus", VirtualMachineStatus_name, VirtualMachineStatus_value)
 	proto.RegisterEnum("os.machine.runtime.KillSignal", KillSignal_name, KillSignal_value)
@@ -992,61 +1583,84 @@ func init() {
 }
 
 var fileDescriptor_48372748125e3de9 = []byte{
-	// 857 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x31, 0x6f, 0xdb, 0x46,
-	0x14, 0xd6, 0x49, 0xb2, 0x22, 0x3d, 0x59, 0x32, 0x73, 0x30, 0x0a, 0x55, 0x01, 0x18, 0x85, 0x41,
-	0x1b, 0x21, 0x40, 0xc9, 0xc2, 0x05, 0x3a, 0x57, 0xb1, 0x18, 0x9b, 0xb0, 0xad, 0x28, 0x94, 0x9c,
-	0xa1, 0x40, 0x41, 0x5c, 0xa4, 0xb3, 0x7c, 0x28, 0x29, 0x32, 0xe4, 0xd1, 0x89, 0x87, 0x16, 0x45,
-	0x7f, 0x43, 0xb7, 0xfe, 0x81, 0x8e, 0x1d, 0xda, 0xb1, 0x53, 0x97, 0x8e, 0x1d, 0x3b, 0xd6, 0xfa,
-	0x05, 0x1d, 0x3b, 0x16, 0x77, 0x3c, 0xc7, 0x72, 0x42, 0xd9, 0x53, 0xed, 0x4d, 0xef, 0xbd, 0x8f,
-	0xdf, 0x7d, 0xef, 0xdd, 0xbb, 0xf7, 0x04, 0x8f, 0xa2, 0xaf, 0x67, 0x16, 0x89, 0x98, 0x15, 0x26,
-	0x56, 0x40, 0x26, 0xc7, 0x6c, 0x4e, 0xad, 0x38, 0x9d, 0x73, 0x16, 0x50, 0xeb, 0xe4, 0x53, 0x11,
-	0x31, 0xa3, 0x38, 0xe4, 0x21, 0xc6, 0x61, 0x62, 0x2a, 0x80, 0xa9, 0x00, 0xed, 0xcd, 0x59, 0x38,
-	0x0b, 0x65, 0xd8, 0x12, 0xbf, 0x32, 0x64, 0xfb, 0xde, 0x2c, 0x0c, 0x67, 0x3e, 0xb5, 0xa4, 0xf5,
-	0x32, 0x3d, 0xb2, 0x68, 0x10, 0xf1, 0xd3, 0x2c, 0x68, 0xfc, 0x8c, 0x60, 0xa3, 0x17, 0xb1, 0x11,
-	0x8d, 0x4f, 0xa8, 0x4b, 0x5f, 0xa5, 0x34, 0xe1, 0xf8, 0x01, 0xac, 0x93, 0x88, 0x79, 0xc7, 0x61,
-	0xc2, 0xe7, 0x24, 0xa0, 0x2d, 0xd4, 0x41, 0xdd, 0x9a, 0x5b, 0x27, 0x11, 0xdb, 0x55, 0x2e, 0xfc,
-	0x21, 0x54, 0x05, 0x24, 0x0a, 0x63, 0xde, 0x2a, 0x76, 0x50, 0xb7, 0xe1, 0xde, 0x21, 0x11, 0x1b,
-	0x86, 0x31, 0xc7, 0xf7, 0x41, 0x20, 0x3d, 0x21, 0x28, 0x4c, 0x79, 0xab, 0x24, 0xa3, 0x40, 0x22,
-	0x36, 0xce, 0x3c, 0xf8, 0x1e, 0xd4, 0x58, 0x40, 0x66, 0xd4, 0x9b, 0xb2, 0xb8, 0x55, 0x96, 0xdc,
-	0x55, 0xe9, 0xe8, 0xb3, 0x58, 0x9c, 0x1d, 0x90, 0x37, 0x9e, 0xca, 0x2c, 0x69, 0xad, 0x75, 0x50,
-	0xb7, 0xe4, 0xd6, 0x03, 0xf2, 0xe6, 0x40, 0xb9, 0x8c, 0x1f, 0x11, 0xdc, 0xed, 0x45, 0xec, 0x70,
-	0x9e, 0xdc, 0xa0, 0xe8, 0x47, 0xb0, 0x31, 0xf1, 0x29, 0x99, 0xa7, 0xd1, 0x5b, 0x50, 0x59, 0x82,
-	0x9a, 0xca, 0xad, 0x80, 0x86, 0x0f, 0xf5, 0x7d, 0x96, 0xf0, 0x9b, 0x91, 0x65, 0x7c, 0x03, 0xeb,
-	0xd9, 0x69, 0x49, 0x14, 0xce, 0x13, 0xfa, 0x7f, 0x57, 0xa1, 0x09, 0x45, 0x36, 0x6d, 0x95, 0x3b,
-	0xa5, 0x6e, 0xcd, 0x2d, 0xb2, 0xa9, 0xf1, 0x3d, 0x82, 0xbb, 0xcf, 0x53, 0x1a, 0x9f, 0x8e, 0x38,
-	0xe1, 0x37, 0x75, 0x15, 0xe7, 0x22, 0x90, 0x12, 0xf1, 0x1b, 0x02, 0xbc, 0x2c, 0x42, 0x95, 0x62,
-	0x17, 0x9a, 0x93, 0x98, 0x12, 0x4e, 0xbd, 0x38, 0xd3, 0x25, 0x75, 0xd4, 0xb7, 0x1e, 0x98, 0xef,
-	0xbf, 0x1c, 0x73, 0x5b, 0x22, 0x55, 0x02, 0x6e, 0x63, 0xb2, 0x6c, 0x5e, 0x6e, 0xd8, 0xe2, 0x3b,
-	0x0d, 0xfb, 0x05, 0x54, 0x12, 0x4e, 0x78, 0x9a, 0x48, 0xa5, 0xcd, 0xad, 0x6e, 0x1e, 0xfd, 0x0b,
-	0x16, 0xf3, 0x94, 0xf8, 0xaa, 0x85, 0x47, 0x12, 0xef, 0xaa, 0xef, 0x8c, 0x1f, 0x10, 0x34, 0x2e,
-	0x9d, 0x7f, 0xc3, 0x05, 0xc4, 0x9b, 0xb0, 0x26, 0xd3, 0x91, 0x8f, 0xad, 0xe6, 0x66, 0x86, 0x68,
-	0xad, 0x11, 0x27, 0x31, 0xbf, 0xa5, 0x5b, 0xfd, 0x05, 0x41, 0x7d, 0x8f, 0xf9, 0xfe, 0x2d, 0xd5,
-	0xe4, 0x73, 0xa8, 0x24, 0x6c, 0x36, 0x27, 0xbe, 0x2c, 0x4a, 0x73, 0x4b, 0xcf, 0xbb, 0x56, 0xa1,
-	0x6f, 0x24, 0x51, 0xae, 0x42, 0x1b, 0xdf, 0x42, 0xa3, 0x4f, 0x7d, 0x7a, 0x6b, 0x8f, 0xe1, 0x77,
-	0x24, 0x04, 0x44, 0x7e, 0x78, 0x7a, 0x4b, 0x85, 0xd3, 0xa1, 0x7e, 0xfc, 0xda, 0x9b, 0xd2, 0x23,
-	0xef, 0x88, 0xf9, 0xe7, 0x2d, 0x55, 0x3b, 0x7e, 0xdd, 0xa7, 0x47, 0x4f, 0x99, 0x4f, 0xf1, 0x43,
-	0x68, 0x24, 0x34, 0x66, 0xc4, 0xf7, 0xa6, 0xf4, 0x84, 0x4d, 0x68, 0xab, 0x22, 0x11, 0xeb, 0x99,
-	0xb3, 0x2f, 0x7d, 0x8f, 0xf7, 0x60, 0x33, 0xef, 0xc9, 0xe0, 0x75, 0xa8, 0x6e, 0xbb, 0x76, 0x6f,
-	0xec, 0x0c, 0x76, 0xb4, 0x02, 0xae, 0xc3, 0x1d, 0x69, 0xd9, 0x7d, 0x0d, 0x09, 0xc3, 0x3d, 0x1c,
-	0x0c, 0x44, 0xa4, 0x28, 0x8c, 0xd1, 0xf8, 0xd9, 0x70, 0x68, 0xf7, 0xb5, 0xd2, 0xe3, 0x57, 0x00,
-	0x17, 0x17, 0x25, 0x43, 0xce, 0xce, 0xe0, 0xd9, 0xc0, 0xd6, 0x0a, 0x18, 0xa0, 0x32, 0x72, 0x76,
-	0x76, 0x0f, 0x87, 0x1a, 0x52, 0xbf, 0x9d, 0xc1, 0x58, 0x7d, 0xef, 0xec, 0x3c, 0x3f, 0x74, 0xc6,
-	0x5a, 0x49, 0x05, 0x9e, 0x0e, 0x6d, 0xad, 0xaa, 0x02, 0x7b, 0xce, 0xfe, 0xbe, 0x56, 0x53, 0x46,
-	0x6f, 0xdf, 0x3d, 0xd0, 0x9a, 0xca, 0x18, 0xdb, 0xee, 0x81, 0xb6, 0xb1, 0xf5, 0xeb, 0x1a, 0x68,
-	0x2f, 0x02, 0x37, 0x6b, 0x13, 0xb1, 0x5b, 0xd9, 0x84, 0x62, 0x07, 0xaa, 0xe7, 0x9b, 0x16, 0x3f,
-	0xcc, 0x6b, 0xa7, 0x77, 0xf6, 0x70, 0xfb, 0x03, 0x33, 0xdb, 0xdc, 0xe6, 0xf9, 0xe6, 0x36, 0x6d,
-	0xb1, 0xb9, 0x8d, 0x02, 0x3e, 0x00, 0xb8, 0xd8, 0x80, 0xf8, 0xa3, 0x15, 0x64, 0x97, 0x37, 0xe4,
-	0x15, 0x74, 0x7b, 0x50, 0x16, 0x5b, 0x04, 0xdf, 0xcf, 0x23, 0x5a, 0xda, 0x66, 0xed, 0xce, 0x6a,
-	0x40, 0x36, 0x75, 0x8d, 0x02, 0xfe, 0x0a, 0xe0, 0x62, 0x1a, 0xe7, 0x6b, 0x7b, 0x6f, 0x65, 0xb4,
-	0x3f, 0xbe, 0x0e, 0xf6, 0x96, 0xde, 0x86, 0x4a, 0x36, 0x2c, 0xf1, 0xf5, 0x83, 0xfc, 0x8a, 0x94,
-	0xb7, 0x61, 0x4d, 0x4e, 0x37, 0x9c, 0x9b, 0xd2, 0xf2, 0xe0, 0xbb, 0x82, 0xa4, 0x07, 0x65, 0xd1,
-	0x59, 0xf9, 0x75, 0x5b, 0x1a, 0x5e, 0x57, 0x50, 0xd8, 0x50, 0xc9, 0xe6, 0x45, 0x7e, 0x3a, 0x97,
-	0x66, 0xc9, 0x75, 0x34, 0xe2, 0xd5, 0xaf, 0xa2, 0x59, 0x9a, 0x08, 0xab, 0x69, 0x9e, 0x3c, 0xf9,
-	0xeb, 0x4c, 0x2f, 0xfc, 0x73, 0xa6, 0xa3, 0x7f, 0xcf, 0xf4, 0xc2, 0x77, 0x0b, 0x1d, 0xfd, 0xb4,
-	0xd0, 0xd1, 0x1f, 0x0b, 0x1d, 0xfd, 0xb9, 0xd0, 0xd1, 0xdf, 0x0b, 0x1d, 0x7d, 0xd9, 0x21, 0x3e,
-	0xff, 0x24, 0x4c, 0x56, 0xff, 0x45, 0x7d, 0x59, 0x91, 0xac, 0x9f, 0xfd, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x63, 0x4d, 0x1a, 0x53, 0xca, 0x0a, 0x00, 0x00,
+	// 1224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x17, 0xf5, 0x9b, 0x4f, 0x3f, 0x4c, 0xf3, 0xeb, 0x6f, 0xc1, 0x3a, 0xa8, 0xe2, 0x28, 0x48,
+	0xa2, 0xa6, 0x88, 0x54, 0xb8, 0x40, 0xd7, 0x56, 0x96, 0x14, 0x47, 0xb0, 0xad, 0x28, 0x94, 0x9c,
+	0xa1, 0x40, 0x41, 0x5c, 0xa4, 0xb3, 0x74, 0x30, 0x25, 0x32, 0xe4, 0xc9, 0x89, 0x87, 0x02, 0x5d,
+	0xba, 0xf4, 0xef, 0xe8, 0xd0, 0xa5, 0x63, 0xc6, 0xee, 0x19, 0xdb, 0xa5, 0xe8, 0x58, 0x7b, 0x6f,
+	0x11, 0x34, 0x4b, 0xc7, 0xe2, 0x8e, 0x27, 0x4a, 0x72, 0x28, 0x9b, 0x4b, 0x95, 0x21, 0xd1, 0xa4,
+	0x7b, 0xef, 0xc3, 0xc7, 0x77, 0x9f, 0xcf, 0xbb, 0xbb, 0xc7, 0x83, 0x3b, 0xf6, 0xf1, 0xa0, 0x82,
+	0x6c, 0x52, 0xb1, 0xdc, 0xca, 0x08, 0xf5, 0x86, 0x64, 0x8c, 0x2b, 0xce, 0x64, 0x4c, 0xc9, 0x08,
+	0x57, 0x4e, 0x3e, 0x65, 0x9e, 0xb2, 0xed, 0x58, 0xd4, 0x52, 0x55, 0xcb, 0x2d, 0x0b, 0x40, 0x59,
+	0x00, 0x36, 0x37, 0x06, 0xd6, 0xc0, 0xe2, 0xee, 0x0a, 0xfb, 0xe7, 0x21, 0x37, 0xaf, 0x0d, 0x2c,
+	0x6b, 0x60, 0xe2, 0x0a, 0x1f, 0x3d, 0x99, 0x1c, 0x55, 0xf0, 0xc8, 0xa6, 0xa7, 0xc2, 0xf9, 0xff,
+	0x13, 0x64, 0x92, 0x3e, 0xa2, 0x8b, 0xd1, 0x8b, 0x2f, 0x12, 0xb0, 0x56, 0xb5, 0x49, 0x07, 0x3b,
+	0x27, 0x58, 0xc7, 0x4f, 0x27, 0xd8, 0xa5, 0xea, 0x0d, 0xc8, 0x22, 0x9b, 0x18, 0x43, 0xcb, 0xa5,
+	0x63, 0x34, 0xc2, 0x9a, 0xb4, 0x25, 0x95, 0x64, 0x3d, 0x83, 0x6c, 0xf2, 0x40, 0x98, 0xd4, 0x0f,
+	0x21, 0xcd, 0x20, 0xb6, 0xe5, 0x50, 0x2d, 0xba, 0x25, 0x95, 0x72, 0x7a, 0x0a, 0xd9, 0xa4, 0x6d,
+	0x39, 0x54, 0xbd, 0x0e, 0x0c, 0x69, 0xb0, 0x3c, 0xad, 0x09, 0xd5, 0x62, 0xdc, 0x0b, 0xc8, 0x26,
+	0x5d, 0xcf, 0xa2, 0x5e, 0x03, 0x99, 0x8c, 0xd0, 0x00, 0x1b, 0x7d, 0xe2, 0x68, 0x71, 0x1e, 0x3b,
+	0xcd, 0x0d, 0x75, 0xe2, 0xa8, 0xdb, 0x90, 0x1d, 0xa1, 0xe7, 0x86, 0x98, 0xb0, 0xab, 0x25, 0xb6,
+	0xa4, 0x52, 0x6c, 0x67, 0xed, 0xe5, 0x6b, 0x4d, 0xfa, 0xf5, 0xb5, 0x16, 0xe1, 0xbf, 0x57, 0x5f,
+	0xe8, 0x99, 0x11, 0x7a, 0x7e, 0x20, 0x30, 0xea, 0x47, 0xc0, 0xc2, 0x1b, 0xae, 0xd5, 0x3b, 0xc6,
+	0x54, 0x4b, 0xf2, 0x88, 0x32, 0xb2, 0x49, 0x87, 0x1b, 0xd4, 0xdb, 0xb0, 0x36, 0x73, 0x1b, 0x23,
+	0xab, 0x8f, 0xb5, 0x14, 0x4f, 0x2a, 0xe7, 0x63, 0x0e, 0xac, 0x3e, 0x56, 0x2b, 0xb0, 0xc1, 0x71,
+	0x8c, 0x0a, 0xc7, 0xe8, 0x61, 0x87, 0x1a, 0x47, 0xc4, 0xc4, 0x5a, 0x9a, 0x07, 0x5c, 0x47, 0x82,
+	0x25, 0xa7, 0x86, 0x1d, 0x7a, 0x9f, 0x98, 0x58, 0xbd, 0x07, 0xff, 0x9b, 0x7b, 0xe0, 0x18, 0x9f,
+	0x7a, 0x78, 0x99, 0xe3, 0x15, 0x1f, 0xbf, 0x87, 0x4f, 0x39, 0xfc, 0x13, 0x50, 0x19, 0xbc, 0x67,
+	0x12, 0x3c, 0xa6, 0x46, 0x0f, 0x79, 0x68, 0xe0, 0x68, 0x96, 0x61, 0x8d, 0x3b, 0x6a, 0x88, 0x83,
+	0x6f, 0x79, 0x49, 0x53, 0xd3, 0xf5, 0x91, 0x19, 0x8e, 0x64, 0xd2, 0x74, 0x4d, 0x57, 0xc0, 0x3e,
+	0x86, 0x75, 0x1f, 0xe6, 0x27, 0x9c, 0xe5, 0xc0, 0xbc, 0x00, 0x4e, 0xb3, 0xbd, 0x03, 0xca, 0x14,
+	0xea, 0xa7, 0x9a, 0xe3, 0xc8, 0x9c, 0x87, 0x9c, 0xe6, 0x29, 0x04, 0x74, 0x30, 0x75, 0x08, 0x76,
+	0xb5, 0xbc, 0x2f, 0xa0, 0xee, 0x59, 0xa6, 0xf3, 0x46, 0x13, 0x3a, 0x34, 0xa8, 0x75, 0x8c, 0xc7,
+	0x5e, 0xb0, 0x35, 0x7f, 0xde, 0xd5, 0x09, 0x1d, 0x76, 0x99, 0x83, 0xc7, 0x13, 0xbc, 0x72, 0xb8,
+	0x6d, 0x99, 0xa4, 0x27, 0x5e, 0xae, 0xf8, 0xbc, 0x32, 0x7c, 0x9b, 0x7b, 0xf8, 0x03, 0x45, 0xc8,
+	0xf1, 0xfa, 0xa3, 0xd4, 0xf6, 0x2a, 0x6c, 0x9d, 0xa7, 0xc0, 0x0b, 0x90, 0x52, 0x9b, 0x55, 0x59,
+	0xf1, 0xbb, 0x18, 0xac, 0x57, 0x6d, 0x72, 0x38, 0x76, 0x57, 0x58, 0xb9, 0x77, 0x60, 0xad, 0x67,
+	0x62, 0x34, 0x9e, 0xd8, 0x3e, 0x28, 0xce, 0x41, 0x79, 0x61, 0x9e, 0x02, 0x17, 0x2b, 0x32, 0x71,
+	0xb1, 0x22, 0x03, 0xc4, 0x4d, 0x86, 0x15, 0x37, 0x15, 0x5a, 0xdc, 0x74, 0x08, 0x71, 0xe5, 0xb0,
+	0xe2, 0x42, 0xb0, 0xb8, 0xc5, 0xdf, 0xa2, 0x90, 0xd9, 0x27, 0x2e, 0x5d, 0x91, 0x02, 0x8b, 0xc4,
+	0xc6, 0x43, 0x10, 0x9b, 0x08, 0x4b, 0x6c, 0x32, 0x34, 0xb1, 0xa9, 0x10, 0xc4, 0xa6, 0xc3, 0x12,
+	0x2b, 0x2f, 0x21, 0xf6, 0x1b, 0xc8, 0x7a, 0xbc, 0xba, 0xb6, 0x35, 0x76, 0xf1, 0x7f, 0x4d, 0x6c,
+	0x1e, 0xa2, 0xa4, 0xaf, 0xc5, 0xb7, 0x62, 0x25, 0x59, 0x8f, 0x92, 0x7e, 0xf1, 0xef, 0x28, 0xac,
+	0x3f, 0x9a, 0x60, 0xe7, 0xb4, 0x43, 0x11, 0x5d, 0xd5, 0xfa, 0xda, 0x10, 0x49, 0x48, 0x25, 0x79,
+	0x27, 0xce, 0xb6, 0x7c, 0x96, 0xca, 0x3b, 0xb4, 0x98, 0x7e, 0x96, 0x40, 0x9d, 0x27, 0x5d, 0x48,
+	0xff, 0x00, 0xf2, 0x3d, 0x07, 0x23, 0x8a, 0x0d, 0xc7, 0xd3, 0x81, 0xf3, 0x9e, 0xd9, 0xbe, 0x51,
+	0x7e, 0xb3, 0x35, 0x28, 0xd7, 0x1c, 0x3c, 0x13, 0x4c, 0xcf, 0xf5, 0xe6, 0x87, 0x8b, 0x47, 0x6f,
+	0xf4, 0xc2, 0xd1, 0xfb, 0x25, 0x24, 0x5d, 0x8a, 0xe8, 0xc4, 0xe5, 0xca, 0xe4, 0xb7, 0x4b, 0x41,
+	0xe1, 0x1f, 0x13, 0x87, 0x4e, 0x90, 0x29, 0xce, 0xde, 0x0e, 0xc7, 0xeb, 0xe2, 0xb9, 0xe2, 0xf7,
+	0x31, 0xc8, 0x2d, 0xbc, 0xff, 0xed, 0x14, 0xcc, 0x26, 0x24, 0xf8, 0xa4, 0xb4, 0xc4, 0x9c, 0xc3,
+	0x33, 0x5d, 0xd5, 0x2b, 0x04, 0x14, 0x53, 0x2a, 0x6c, 0x31, 0xa5, 0x43, 0x17, 0x93, 0x1c, 0xa2,
+	0x98, 0x20, 0x6c, 0x31, 0x65, 0x96, 0x14, 0xd3, 0x9f, 0x51, 0xc8, 0x76, 0x28, 0x72, 0xe8, 0xfb,
+	0xc5, 0xbb, 0x92, 0xc5, 0xfb, 0x43, 0x0c, 0x32, 0x7b, 0xc4, 0x34, 0xdf, 0x2a, 0xdd, 0x9f, 0x43,
+	0xd2, 0x25, 0x83, 0x31, 0x32, 0x39, 0xd5, 0xf9, 0xed, 0x42, 0xd0, 0x1a, 0x66, 0x59, 0x76, 0x38,
+	0x4a, 0x17, 0xe8, 0x77, 0x68, 0x59, 0xfc, 0x15, 0x85, 0x5c, 0x1d, 0x9b, 0xf8, 0xfd, 0xa1, 0xb6,
+	0xa2, 0x75, 0xf1, 0x53, 0x8c, 0x11, 0x6e, 0x9b, 0xd6, 0xe9, 0x8a, 0x08, 0xcf, 0xcf, 0x08, 0xe7,
+	0x54, 0x17, 0x20, 0x33, 0x7c, 0x66, 0xf4, 0xf1, 0xd1, 0x7c, 0x43, 0x28, 0x0f, 0x9f, 0xd5, 0xf1,
+	0x11, 0x9f, 0xf0, 0x4d, 0xc8, 0xb9, 0xd8, 0x21, 0xc8, 0x34, 0xfa, 0xf8, 0x84, 0xf4, 0x7c, 0xa6,
+	0x3d, 0x63, 0x9d, 0xdb, 0x2e, 0xe8, 0x95, 0x0a, 0xa1, 0x57, 0x3a, 0xac, 0x5e, 0x72, 0x68, 0xbd,
+	0x20, 0x84, 0x5e, 0x99, 0xb0, 0x7a, 0x65, 0x83, 0xf5, 0xba, 0xbb, 0x07, 0x1b, 0x41, 0x87, 0xbc,
+	0x9a, 0x85, 0x74, 0x4d, 0x6f, 0x54, 0xbb, 0xcd, 0xd6, 0xae, 0x12, 0x51, 0x33, 0x90, 0xe2, 0xa3,
+	0x46, 0x5d, 0x91, 0xd8, 0x40, 0x3f, 0x6c, 0xb5, 0x98, 0x27, 0xca, 0x06, 0x9d, 0xee, 0xc3, 0x76,
+	0xbb, 0x51, 0x57, 0x62, 0x77, 0x9f, 0x02, 0xcc, 0x76, 0x1b, 0xee, 0x6a, 0xee, 0xb6, 0x1e, 0xb6,
+	0x1a, 0x4a, 0x44, 0x05, 0x48, 0x76, 0x9a, 0xbb, 0x0f, 0x0e, 0xdb, 0x8a, 0x24, 0xfe, 0x37, 0x5b,
+	0x5d, 0xf1, 0x7c, 0x73, 0xf7, 0xd1, 0x61, 0xb3, 0xab, 0xc4, 0x84, 0xe3, 0x7e, 0xbb, 0xa1, 0xa4,
+	0x85, 0x63, 0xaf, 0xb9, 0xbf, 0xaf, 0xc8, 0x62, 0x50, 0xdd, 0xd7, 0x0f, 0x94, 0xbc, 0x18, 0x74,
+	0x1b, 0xfa, 0x81, 0xb2, 0xb6, 0xfd, 0x22, 0x01, 0xca, 0xe3, 0x91, 0xee, 0xed, 0x75, 0xec, 0x0b,
+	0x9c, 0xc9, 0xd7, 0x84, 0xf4, 0xf4, 0x96, 0x43, 0xbd, 0x19, 0xb4, 0x27, 0x5e, 0xb8, 0x03, 0xd9,
+	0xfc, 0xa0, 0xec, 0x5d, 0xa6, 0x94, 0xa7, 0x97, 0x29, 0xe5, 0x06, 0xbb, 0x4c, 0x29, 0x46, 0xd4,
+	0x03, 0x80, 0xd9, 0x87, 0xa7, 0x7a, 0x6b, 0x49, 0xb0, 0xc5, 0x0f, 0xd3, 0x4b, 0xc2, 0xed, 0x41,
+	0x9c, 0xf5, 0xf9, 0xea, 0xf5, 0xa0, 0x40, 0x73, 0x5f, 0x56, 0x9b, 0x5b, 0xcb, 0x01, 0x5e, 0x9f,
+	0x58, 0x8c, 0xa8, 0x5f, 0x03, 0xcc, 0xfa, 0xc7, 0xe0, 0xdc, 0xde, 0x68, 0xea, 0x37, 0x6f, 0x5f,
+	0x05, 0xf3, 0xc3, 0x37, 0x20, 0xe9, 0xb5, 0x77, 0xea, 0xd5, 0xad, 0xe7, 0x25, 0x53, 0xae, 0x41,
+	0x82, 0x37, 0x26, 0x6a, 0xe0, 0x94, 0xe6, 0x7b, 0x96, 0x4b, 0x82, 0x54, 0x21, 0xce, 0x2a, 0x2b,
+	0x98, 0xb7, 0xb9, 0x73, 0xf8, 0x92, 0x10, 0x0d, 0x48, 0x7a, 0x27, 0x41, 0xf0, 0x74, 0x16, 0x4e,
+	0x89, 0xab, 0xc2, 0xb0, 0xfd, 0x6d, 0x59, 0x98, 0xb9, 0xbd, 0x6f, 0x79, 0x98, 0x9d, 0x9d, 0xdf,
+	0xcf, 0x0a, 0x91, 0x57, 0x67, 0x05, 0xe9, 0x9f, 0xb3, 0x42, 0xe4, 0xdb, 0xf3, 0x82, 0xf4, 0xe3,
+	0x79, 0x41, 0x7a, 0x79, 0x5e, 0x90, 0x7e, 0x39, 0x2f, 0x48, 0x7f, 0x9c, 0x17, 0xa4, 0xaf, 0xb6,
+	0x90, 0x49, 0xef, 0x59, 0xee, 0xf2, 0x5b, 0xc3, 0x27, 0x49, 0x1e, 0xf5, 0xb3, 0x7f, 0x07, 0x00,
+	0xab, 0x55, 0x47, 0x59, 0x5d, 0x14, 0x00, 0x00,
 }
 
 func (this *ApiServeRequest) Equal(that interface{}) bool {
@@ -1083,6 +1697,42 @@ func (this *ApiServeRequest) Equal(that interface{}) bool {
 	if this.MaxMachines != that1.MaxMachines {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiSocketMode != that1.ApiSocketMode {
+		return false
+	}
+	if this.ApiServerCertFile != that1.ApiServerCertFile {
+		return false
+	}
+	if this.ApiServerKeyFile != that1.ApiServerKeyFile {
+		return false
+	}
+	if this.ApiClientCaFile != that1.ApiClientCaFile {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
+	if this.ApiAuthPolicyFile != that1.ApiAuthPolicyFile {
+		return false
+	}
+	if this.ApiHttpPort != that1.ApiHttpPort {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1119,6 +1769,24 @@ func (this *ApiUnserveRequest) Equal(that interface{}) bool {
 	if this.CleanupTimeout != that1.CleanupTimeout {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1152,6 +1820,24 @@ func (this *ListRequest) Equal(that interface{}) bool {
 	if this.ApiTimeout != that1.ApiTimeout {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1229,6 +1915,24 @@ func (this *QueryStateRequest) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1301,6 +2005,24 @@ func (this *CreateRequest) Equal(that interface{}) bool {
 	if this.Image != that1.Image {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1337,6 +2059,24 @@ func (this *StartRequest) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1376,6 +2116,24 @@ func (this *KillRequest) Equal(that interface{}) bool {
 	if this.Signal != that1.Signal {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1412,6 +2170,24 @@ func (this *DeleteRequest) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1454,6 +2230,24 @@ func (this *DeployRequest) Equal(that interface{}) bool {
 	if this.SerialDevice != that1.SerialDevice {
 		return false
 	}
+	if this.ApiSocket != that1.ApiSocket {
+		return false
+	}
+	if this.ApiTlsCaFile != that1.ApiTlsCaFile {
+		return false
+	}
+	if this.ApiTlsCertFile != that1.ApiTlsCertFile {
+		return false
+	}
+	if this.ApiTlsKeyFile != that1.ApiTlsKeyFile {
+		return false
+	}
+	if this.ApiRetries != that1.ApiRetries {
+		return false
+	}
+	if this.ApiAuthTokenFile != that1.ApiAuthTokenFile {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1463,13 +2257,25 @@ func (this *ApiServeRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 21)
 	s = append(s, "&v0.ApiServeRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "ImageDir: "+fmt.Sprintf("%#v", this.ImageDir)+",\n")
 	s = append(s, "MaxMachines: "+fmt.Sprintf("%#v", this.MaxMachines)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiSocketMode: "+fmt.Sprintf("%#v", this.ApiSocketMode)+",\n")
+	s = append(s, "ApiServerCertFile: "+fmt.Sprintf("%#v", this.ApiServerCertFile)+",\n")
+	s = append(s, "ApiServerKeyFile: "+fmt.Sprintf("%#v", this.ApiServerKeyFile)+",\n")
+	s = append(s, "ApiClientCaFile: "+fmt.Sprintf("%#v", this.ApiClientCaFile)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
+	s = append(s, "ApiAuthPolicyFile: "+fmt.Sprintf("%#v", this.ApiAuthPolicyFile)+",\n")
+	s = append(s, "ApiHttpPort: "+fmt.Sprintf("%#v", this.ApiHttpPort)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1480,12 +2286,18 @@ func (this *ApiUnserveRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 14)
 	s = append(s, "&v0.ApiUnserveRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "CleanupTimeout: "+fmt.Sprintf("%#v", this.CleanupTimeout)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1496,11 +2308,17 @@ func (this *ListRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 13)
 	s = append(s, "&v0.ListRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1527,12 +2345,18 @@ func (this *QueryStateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 14)
 	s = append(s, "&v0.QueryStateRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1560,13 +2384,19 @@ func (this *CreateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 15)
 	s = append(s, "&v0.CreateRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "Image: "+fmt.Sprintf("%#v", this.Image)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1577,12 +2407,18 @@ func (this *StartRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 14)
 	s = append(s, "&v0.StartRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1593,13 +2429,19 @@ func (this *KillRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 15)
 	s = append(s, "&v0.KillRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "Signal: "+fmt.Sprintf("%#v", this.Signal)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1610,12 +2452,18 @@ func (this *DeleteRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 14)
 	s = append(s, "&v0.DeleteRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
 	s = append(s, "ApiTimeout: "+fmt.Sprintf("%#v", this.ApiTimeout)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -1626,7 +2474,7 @@ func (this *DeployRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 16)
 	s = append(s, "&v0.DeployRequest{")
 	s = append(s, "ApiHostname: "+fmt.Sprintf("%#v", this.ApiHostname)+",\n")
 	s = append(s, "ApiPort: "+fmt.Sprintf("%#v", this.ApiPort)+",\n")
@@ -1634,6 +2482,12 @@ func (this *DeployRequest) GoString() string {
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "HwDefFile: "+fmt.Sprintf("%#v", this.HwDefFile)+",\n")
 	s = append(s, "SerialDevice: "+fmt.Sprintf("%#v", this.SerialDevice)+",\n")
+	s = append(s, "ApiSocket: "+fmt.Sprintf("%#v", this.ApiSocket)+",\n")
+	s = append(s, "ApiTlsCaFile: "+fmt.Sprintf("%#v", this.ApiTlsCaFile)+",\n")
+	s = append(s, "ApiTlsCertFile: "+fmt.Sprintf("%#v", this.ApiTlsCertFile)+",\n")
+	s = append(s, "ApiTlsKeyFile: "+fmt.Sprintf("%#v", this.ApiTlsKeyFile)+",\n")
+	s = append(s, "ApiRetries: "+fmt.Sprintf("%#v", this.ApiRetries)+",\n")
+	s = append(s, "ApiAuthTokenFile: "+fmt.Sprintf("%#v", this.ApiAuthTokenFile)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -2059,6 +2913,88 @@ func (m *ApiServeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ApiHttpPort != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiHttpPort))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.ApiAuthPolicyFile) > 0 {
+		i -= len(m.ApiAuthPolicyFile)
+		copy(dAtA[i:], m.ApiAuthPolicyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthPolicyFile)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ApiClientCaFile) > 0 {
+		i -= len(m.ApiClientCaFile)
+		copy(dAtA[i:], m.ApiClientCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiClientCaFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ApiServerKeyFile) > 0 {
+		i -= len(m.ApiServerKeyFile)
+		copy(dAtA[i:], m.ApiServerKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiServerKeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiServerCertFile) > 0 {
+		i -= len(m.ApiServerCertFile)
+		copy(dAtA[i:], m.ApiServerCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiServerCertFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ApiSocketMode != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiSocketMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.MaxMachines != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.MaxMachines))
 		i--
@@ -2115,6 +3051,46 @@ func (m *ApiUnserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.CleanupTimeout != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.CleanupTimeout))
 		i--
@@ -2164,6 +3140,46 @@ func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.ApiTimeout != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.ApiTimeout))
 		i--
@@ -2261,14 +3277,54 @@ func (m *QueryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x52
 	}
-	if m.ApiTimeout != 0 {
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ApiTimeout != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.ApiTimeout))
 		i--
 		dAtA[i] = 0x18
@@ -2363,6 +3419,46 @@ func (m *CreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Image) > 0 {
 		i -= len(m.Image)
 		copy(dAtA[i:], m.Image)
@@ -2421,6 +3517,46 @@ func (m *StartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -2472,6 +3608,46 @@ func (m *KillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Signal != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.Signal))
 		i--
@@ -2528,6 +3704,46 @@ func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -2579,6 +3795,46 @@ func (m *DeployRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApiAuthTokenFile) > 0 {
+		i -= len(m.ApiAuthTokenFile)
+		copy(dAtA[i:], m.ApiAuthTokenFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiAuthTokenFile)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ApiRetries != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ApiRetries))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ApiTlsKeyFile) > 0 {
+		i -= len(m.ApiTlsKeyFile)
+		copy(dAtA[i:], m.ApiTlsKeyFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsKeyFile)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ApiTlsCertFile) > 0 {
+		i -= len(m.ApiTlsCertFile)
+		copy(dAtA[i:], m.ApiTlsCertFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCertFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ApiTlsCaFile) > 0 {
+		i -= len(m.ApiTlsCaFile)
+		copy(dAtA[i:], m.ApiTlsCaFile)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiTlsCaFile)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiSocket) > 0 {
+		i -= len(m.ApiSocket)
+		copy(dAtA[i:], m.ApiSocket)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ApiSocket)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.SerialDevice) > 0 {
 		i -= len(m.SerialDevice)
 		copy(dAtA[i:], m.SerialDevice)
@@ -2654,6 +3910,51 @@ func (m *ApiServeRequest) Size() (n int) {
 	if m.MaxMachines != 0 {
 		n += 1 + sovApi(uint64(m.MaxMachines))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiSocketMode != 0 {
+		n += 1 + sovApi(uint64(m.ApiSocketMode))
+	}
+	l = len(m.ApiServerCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiServerKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiClientCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiAuthPolicyFile)
+	if l > 0 {
+		n += 2 + l + sovApi(uint64(l))
+	}
+	if m.ApiHttpPort != 0 {
+		n += 2 + sovApi(uint64(m.ApiHttpPort))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2679,6 +3980,29 @@ func (m *ApiUnserveRequest) Size() (n int) {
 	if m.CleanupTimeout != 0 {
 		n += 1 + sovApi(uint64(m.CleanupTimeout))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2701,6 +4025,29 @@ func (m *ListRequest) Size() (n int) {
 	if m.ApiTimeout != 0 {
 		n += 1 + sovApi(uint64(m.ApiTimeout))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2755,6 +4102,29 @@ func (m *QueryStateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2808,6 +4178,29 @@ func (m *CreateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2834,6 +4227,29 @@ func (m *StartRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2863,6 +4279,29 @@ func (m *KillRequest) Size() (n int) {
 	if m.Signal != 0 {
 		n += 1 + sovApi(uint64(m.Signal))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2889,6 +4328,29 @@ func (m *DeleteRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2923,6 +4385,29 @@ func (m *DeployRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.ApiSocket)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCaFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsCertFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.ApiTlsKeyFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.ApiRetries != 0 {
+		n += 1 + sovApi(uint64(m.ApiRetries))
+	}
+	l = len(m.ApiAuthTokenFile)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2945,6 +4430,18 @@ func (this *ApiServeRequest) String() string {
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`ImageDir:` + fmt.Sprintf("%v", this.ImageDir) + `,`,
 		`MaxMachines:` + fmt.Sprintf("%v", this.MaxMachines) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiSocketMode:` + fmt.Sprintf("%v", this.ApiSocketMode) + `,`,
+		`ApiServerCertFile:` + fmt.Sprintf("%v", this.ApiServerCertFile) + `,`,
+		`ApiServerKeyFile:` + fmt.Sprintf("%v", this.ApiServerKeyFile) + `,`,
+		`ApiClientCaFile:` + fmt.Sprintf("%v", this.ApiClientCaFile) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
+		`ApiAuthPolicyFile:` + fmt.Sprintf("%v", this.ApiAuthPolicyFile) + `,`,
+		`ApiHttpPort:` + fmt.Sprintf("%v", this.ApiHttpPort) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2959,6 +4456,12 @@ func (this *ApiUnserveRequest) String() string {
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`CleanupTimeout:` + fmt.Sprintf("%v", this.CleanupTimeout) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -2972,6 +4475,12 @@ func (this *ListRequest) String() string {
 		`ApiHostname:` + fmt.Sprintf("%v", this.ApiHostname) + `,`,
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -3000,6 +4509,12 @@ func (this *QueryStateRequest) String() string {
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -3028,6 +4543,12 @@ func (this *CreateRequest) String() string {
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -3042,6 +4563,12 @@ func (this *StartRequest) String() string {
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -3057,6 +4584,12 @@ func (this *KillRequest) String() string {
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -3071,6 +4604,12 @@ func (this *DeleteRequest) String() string {
 		`ApiPort:` + fmt.Sprintf("%v", this.ApiPort) + `,`,
 		`ApiTimeout:` + fmt.Sprintf("%v", this.ApiTimeout) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -3087,6 +4626,12 @@ func (this *DeployRequest) String() string {
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`HwDefFile:` + fmt.Sprintf("%v", this.HwDefFile) + `,`,
 		`SerialDevice:` + fmt.Sprintf("%v", this.SerialDevice) + `,`,
+		`ApiSocket:` + fmt.Sprintf("%v", this.ApiSocket) + `,`,
+		`ApiTlsCaFile:` + fmt.Sprintf("%v", this.ApiTlsCaFile) + `,`,
+		`ApiTlsCertFile:` + fmt.Sprintf("%v", this.ApiTlsCertFile) + `,`,
+		`ApiTlsKeyFile:` + fmt.Sprintf("%v", this.ApiTlsKeyFile) + `,`,
+		`ApiRetries:` + fmt.Sprintf("%v", this.ApiRetries) + `,`,
+		`ApiAuthTokenFile:` + fmt.Sprintf("%v", this.ApiAuthTokenFile) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -3250,17 +4795,1604 @@ func (m *ApiServeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocketMode", wireType)
+			}
+			m.ApiSocketMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiSocketMode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiServerCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiServerCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiServerKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiServerKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiClientCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiClientCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthPolicyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthPolicyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHttpPort", wireType)
+			}
+			m.ApiHttpPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiHttpPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApiUnserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApiUnserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanupTimeout", wireType)
+			}
+			m.CleanupTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CleanupTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateRequest == nil {
+				m.CreateRequest = &CreateRequest{}
+			}
+			if err := m.CreateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VirtualMachineStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -3272,7 +6404,7 @@ func (m *ApiServeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3295,15 +6427,149 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApiUnserveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApiUnserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3331,13 +6597,13 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3347,35 +6613,29 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ApiTimeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanupTimeout", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CleanupTimeout = 0
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3385,65 +6645,27 @@ func (m *ApiUnserveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CleanupTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3471,13 +6693,13 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
 			}
-			m.ApiPort = 0
+			m.ApiRetries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3487,16 +6709,16 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				m.ApiRetries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
-			m.ApiTimeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3506,11 +6728,24 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -3533,7 +6768,7 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListResponse) Unmarshal(dAtA []byte) error {
+func (m *StartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3556,10 +6791,10 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3662,62 +6897,75 @@ func (m *ListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3745,13 +6993,13 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3761,16 +7009,29 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
 			}
-			m.ApiTimeout = 0
+			m.ApiRetries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3780,14 +7041,14 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				m.ApiRetries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3815,7 +7076,7 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3839,7 +7100,7 @@ func (m *QueryStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
+func (m *KillRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3862,17 +7123,17 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: KillRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3882,31 +7143,65 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateRequest == nil {
-				m.CreateRequest = &CreateRequest{}
-			}
-			if err := m.CreateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ApiHostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+			}
+			m.ApiPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			}
+			m.ApiTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3934,13 +7229,13 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageDir = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
 			}
-			m.Status = 0
+			m.Signal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3950,65 +7245,14 @@ func (m *QueryStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= VirtualMachineStatus(b&0x7F) << shift
+				m.Signal |= KillSignal(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4036,13 +7280,13 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4052,16 +7296,29 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ApiTimeout = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4071,14 +7328,27 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4106,11 +7376,30 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4138,7 +7427,7 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4162,7 +7451,7 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4185,10 +7474,10 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4261,9 +7550,73 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4291,62 +7644,11 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KillRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KillRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4374,13 +7676,13 @@ func (m *KillRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4390,16 +7692,29 @@ func (m *KillRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
 			}
-			m.ApiTimeout = 0
+			m.ApiRetries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4409,14 +7724,14 @@ func (m *KillRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				m.ApiRetries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4444,27 +7759,8 @@ func (m *KillRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
-			}
-			m.Signal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Signal |= KillSignal(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -4487,7 +7783,7 @@ func (m *KillRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *DeployRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4510,10 +7806,10 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeployRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeployRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4618,60 +7914,41 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HwDefFile", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeployRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeployRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeployRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.HwDefFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiHostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SerialDevice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4699,13 +7976,13 @@ func (m *DeployRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiHostname = string(dAtA[iNdEx:postIndex])
+			m.SerialDevice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiPort", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiSocket", wireType)
 			}
-			m.ApiPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4715,16 +7992,29 @@ func (m *DeployRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiTimeout", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.ApiTimeout = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiSocket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCaFile", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4734,14 +8024,27 @@ func (m *DeployRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApiTimeout |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiTlsCaFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsCertFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4769,11 +8072,11 @@ func (m *DeployRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsCertFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HwDefFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiTlsKeyFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4801,11 +8104,30 @@ func (m *DeployRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HwDefFile = string(dAtA[iNdEx:postIndex])
+			m.ApiTlsKeyFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRetries", wireType)
+			}
+			m.ApiRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApiRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SerialDevice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiAuthTokenFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4833,7 +8155,7 @@ func (m *DeployRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SerialDevice = string(dAtA[iNdEx:postIndex])
+			m.ApiAuthTokenFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+// unixPeerCreds returns the user and group id of the peer process of a unix
+// domain socket connection.
+func unixPeerCreds(conn net.Conn) (uint32, uint32, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return 0, 0, errors.New("not a unix domain socket connection")
+	}
+	rawConn, err := unixConn.SyscallConn()
+	if err != nil {
+		return 0, 0, err
+	}
+	var ucred *syscall.Ucred
+	var credErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		ucred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil {
+		return 0, 0, err
+	} else if credErr != nil {
+		return 0, 0, credErr
+	}
+	return ucred.Uid, ucred.Gid, nil
+}
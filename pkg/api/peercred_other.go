@@ -0,0 +1,15 @@
+//go:build !linux
+// +build !linux
+
+package api
+
+import (
+	"errors"
+	"net"
+)
+
+// unixPeerCreds returns the user and group id of the peer process of a unix
+// domain socket connection. Peer credentials are only supported on linux.
+func unixPeerCreds(conn net.Conn) (uint32, uint32, error) {
+	return 0, 0, errors.New("peer credentials not supported")
+}
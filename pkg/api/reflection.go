@@ -0,0 +1,242 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	golangproto "github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+// Path prefix of the api proto files as registered by the generated code,
+// which their imports of each other omit.
+const _PROTO_REGISTERED_PREFIX = "pkg/api/"
+
+// Names that gogo registers imported proto files under, by import path.
+var protoImportAliases = map[string]string{
+	"gogoproto/gogo.proto":             "gogo.proto",
+	"google/protobuf/descriptor.proto": "descriptor.proto",
+}
+
+// reflectionServer implements the gRPC server reflection service using the
+// file descriptors of the gogo registry, which the standard grpc reflection
+// package cannot see.
+type reflectionServer struct {
+	rpb.UnimplementedServerReflectionServer
+	grpcServer *grpc.Server
+	served     func(serviceName string) bool
+
+	initSymbols sync.Once
+	symbolFiles map[string]string // Fully-qualified symbol names to file import paths.
+}
+
+// registerReflection registers the server reflection service on the server.
+// Only services for which served returns true are listed.
+func registerReflection(grpcServer *grpc.Server, served func(serviceName string) bool) {
+	rpb.RegisterServerReflectionServer(grpcServer, &reflectionServer{grpcServer: grpcServer, served: served})
+}
+
+// ServerReflectionInfo answers each reflection request of the stream.
+func (s *reflectionServer) ServerReflectionInfo(stream rpb.ServerReflection_ServerReflectionInfoServer) error {
+	sentFiles := make(map[string]bool)
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		resp := &rpb.ServerReflectionResponse{ValidHost: req.Host, OriginalRequest: req}
+		var files [][]byte
+		switch msgReq := req.MessageRequest.(type) {
+		case *rpb.ServerReflectionRequest_FileByFilename:
+			files, err = fileWithDependencies(msgReq.FileByFilename, sentFiles)
+		case *rpb.ServerReflectionRequest_FileContainingSymbol:
+			if filename, ok := s.symbolFile(msgReq.FileContainingSymbol); !ok {
+				err = errors.New("unknown symbol: " + msgReq.FileContainingSymbol)
+			} else {
+				files, err = fileWithDependencies(filename, sentFiles)
+			}
+		case *rpb.ServerReflectionRequest_FileContainingExtension:
+			err = errors.New("extensions not supported")
+		case *rpb.ServerReflectionRequest_AllExtensionNumbersOfType:
+			resp.MessageResponse = &rpb.ServerReflectionResponse_AllExtensionNumbersResponse{
+				AllExtensionNumbersResponse: &rpb.ExtensionNumberResponse{
+					BaseTypeName: msgReq.AllExtensionNumbersOfType,
+				},
+			}
+		case *rpb.ServerReflectionRequest_ListServices:
+			listResp := &rpb.ListServiceResponse{}
+			for _, name := range s.serviceNames() {
+				listResp.Service = append(listResp.Service, &rpb.ServiceResponse{Name: name})
+			}
+			resp.MessageResponse = &rpb.ServerReflectionResponse_ListServicesResponse{
+				ListServicesResponse: listResp,
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid reflection request: %v", req.MessageRequest)
+		}
+		if err != nil {
+			resp.MessageResponse = &rpb.ServerReflectionResponse_ErrorResponse{
+				ErrorResponse: &rpb.ErrorResponse{
+					ErrorCode:    int32(codes.NotFound),
+					ErrorMessage: err.Error(),
+				},
+			}
+		} else if files != nil || resp.MessageResponse == nil {
+			resp.MessageResponse = &rpb.ServerReflectionResponse_FileDescriptorResponse{
+				FileDescriptorResponse: &rpb.FileDescriptorResponse{FileDescriptorProto: files},
+			}
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// symbolFile returns the import path of the file defining the symbol.
+func (s *reflectionServer) symbolFile(symbol string) (string, bool) {
+	filename, ok := s.symbols()[symbol]
+	return filename, ok
+}
+
+// serviceNames returns the sorted names of the served services of the server.
+func (s *reflectionServer) serviceNames() []string {
+	var names []string
+	for name := range s.grpcServer.GetServiceInfo() {
+		if s.served(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// symbols indexes the symbols defined by the files of the services of the
+// server and the files they depend on.
+func (s *reflectionServer) symbols() map[string]string {
+	s.initSymbols.Do(func() {
+		s.symbolFiles = make(map[string]string)
+		visited := make(map[string]bool)
+		for _, info := range s.grpcServer.GetServiceInfo() {
+			if filename, ok := info.Metadata.(string); ok {
+				s.indexFile(filename, visited)
+			}
+		}
+	})
+	return s.symbolFiles
+}
+
+// indexFile adds the symbols of the file and its dependencies to the index.
+func (s *reflectionServer) indexFile(filename string, visited map[string]bool) {
+	fd, err := loadFileDescriptor(filename)
+	if err != nil || visited[fd.GetName()] {
+		return
+	}
+	visited[fd.GetName()] = true
+	prefix := fd.GetPackage()
+	if prefix != "" {
+		prefix += "."
+	}
+	var indexMessage func(prefix string, msg *descriptor.DescriptorProto)
+	indexMessage = func(prefix string, msg *descriptor.DescriptorProto) {
+		name := prefix + msg.GetName()
+		s.symbolFiles[name] = fd.GetName()
+		for _, nested := range msg.NestedType {
+			indexMessage(name+".", nested)
+		}
+		for _, enum := range msg.EnumType {
+			s.symbolFiles[name+"."+enum.GetName()] = fd.GetName()
+		}
+	}
+	for _, msg := range fd.MessageType {
+		indexMessage(prefix, msg)
+	}
+	for _, enum := range fd.EnumType {
+		s.symbolFiles[prefix+enum.GetName()] = fd.GetName()
+	}
+	for _, service := range fd.Service {
+		serviceName := prefix + service.GetName()
+		s.symbolFiles[serviceName] = fd.GetName()
+		for _, method := range service.Method {
+			s.symbolFiles[serviceName+"."+method.GetName()] = fd.GetName()
+		}
+	}
+	for _, dep := range fd.Dependency {
+		s.indexFile(dep, visited)
+	}
+}
+
+// fileWithDependencies returns the serialized descriptors of the file and of
+// its transitive dependencies that were not already sent on the stream.
+func fileWithDependencies(filename string, sentFiles map[string]bool) ([][]byte, error) {
+	fd, err := loadFileDescriptor(filename)
+	if err != nil {
+		return nil, err
+	}
+	var files [][]byte
+	queue := []*descriptor.FileDescriptorProto{fd}
+	for len(queue) > 0 {
+		fd, queue = queue[0], queue[1:]
+		if sentFiles[fd.GetName()] {
+			continue
+		}
+		sentFiles[fd.GetName()] = true
+		if data, err := proto.Marshal(fd); err != nil {
+			return nil, err
+		} else {
+			files = append(files, data)
+		}
+		for _, dep := range fd.Dependency {
+			if depFd, err := loadFileDescriptor(dep); err == nil {
+				queue = append(queue, depFd)
+			}
+		}
+	}
+	return files, nil
+}
+
+// loadFileDescriptor returns the registered descriptor of the proto file with
+// the specified import or registered path. The descriptor is named by its
+// import path so that it matches the dependencies of the files importing it.
+func loadFileDescriptor(filename string) (*descriptor.FileDescriptorProto, error) {
+	importPath := strings.TrimPrefix(filename, _PROTO_REGISTERED_PREFIX)
+	gzipped := proto.FileDescriptor(_PROTO_REGISTERED_PREFIX + importPath)
+	if gzipped == nil {
+		gzipped = proto.FileDescriptor(importPath)
+	}
+	if alias, ok := protoImportAliases[importPath]; ok && gzipped == nil {
+		gzipped = proto.FileDescriptor(alias)
+	}
+	if gzipped == nil {
+		gzipped = golangproto.FileDescriptor(importPath)
+	}
+	if gzipped == nil {
+		return nil, errors.New("unknown file: " + filename)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(gzipped))
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	fd := &descriptor.FileDescriptorProto{}
+	if err := proto.Unmarshal(data, fd); err != nil {
+		return nil, err
+	}
+	fd.Name = proto.String(importPath)
+	return fd, nil
+}
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+// Status of a message after servicing the message queue.
+type messageStatus int
+
+const (
+	_MESSAGE_SUCCEEDED messageStatus = iota
+	_MESSAGE_FAILED
+	_MESSAGE_SKIPPED
+)
+
+func (s messageStatus) String() string {
+	switch s {
+	case _MESSAGE_SUCCEEDED:
+		return "succeeded"
+	case _MESSAGE_FAILED:
+		return "failed"
+	case _MESSAGE_SKIPPED:
+		return "skipped"
+	}
+	return "unknown"
+}
+
+// messageResult holds the outcome of servicing a single message of the queue.
+type messageResult struct {
+	id     string
+	kind   string
+	status messageStatus
+	err    error
+	resp   proto.Message
+}
+
+// messageNode is a message in the dependency graph of the message queue.
+type messageNode struct {
+	index      int
+	msg        *ApiProtoMessage
+	deps       []*messageNode
+	dependents []*messageNode
+}
+
+// messageId returns the identifier of the message at the specified queue index,
+// which is the message id if set or its 1-based position in the queue otherwise.
+func messageId(msg *ApiProtoMessage, index int) string {
+	if msg.Id != "" {
+		return msg.Id
+	}
+	return "#" + strconv.Itoa(index+1)
+}
+
+// buildMessageGraph returns the dependency graph nodes of the message queue.
+// Messages without explicit dependencies depend on all preceding messages.
+func buildMessageGraph(messages []*ApiProtoMessage) ([]*messageNode, error) {
+	nodes := make([]*messageNode, len(messages))
+	idNodes := make(map[string]*messageNode)
+	for i, msg := range messages {
+		nodes[i] = &messageNode{index: i, msg: msg}
+		if msg.Id == "" {
+			continue
+		} else if _, ok := idNodes[msg.Id]; ok {
+			return nil, errors.New("duplicate message id: " + msg.Id)
+		}
+		idNodes[msg.Id] = nodes[i]
+	}
+	for i, node := range nodes {
+		if len(node.msg.DependsOn) == 0 {
+			node.deps = append(node.deps, nodes[:i]...)
+		}
+		for _, depId := range node.msg.DependsOn {
+			if depNode, ok := idNodes[depId]; !ok {
+				return nil, errors.New("unknown message id in dependsOn: " + depId)
+			} else {
+				node.deps = append(node.deps, depNode)
+			}
+		}
+		for _, depNode := range node.deps {
+			depNode.dependents = append(depNode.dependents, node)
+		}
+	}
+	if err := checkMessageGraphAcyclic(nodes); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// checkMessageGraphAcyclic returns an error if the message dependencies form a cycle.
+func checkMessageGraphAcyclic(nodes []*messageNode) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(nodes))
+	var visit func(node *messageNode, path []string) error
+	visit = func(node *messageNode, path []string) error {
+		path = append(path, messageId(node.msg, node.index))
+		switch state[node.index] {
+		case visiting:
+			return errors.New("message dependency cycle: " + strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+		state[node.index] = visiting
+		for _, depNode := range node.deps {
+			if err := visit(depNode, path); err != nil {
+				return err
+			}
+		}
+		state[node.index] = visited
+		return nil
+	}
+	for _, node := range nodes {
+		if err := visit(node, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serviceMessageGraph processes the messages of the queue concurrently in
+// dependency order. A message is processed once all of its dependencies
+// succeed, and is skipped if any of them fails or is skipped.
+func serviceMessageGraph(ctxt *ApiServiceContext) ([]*messageResult, error) {
+	nodes, err := buildMessageGraph(ctxt.MessageQueue)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*messageResult, len(nodes))
+	remaining := make([]int, len(nodes))
+	resultsLock := sync.Mutex{}
+	wg := sync.WaitGroup{}
+
+	var finish func(node *messageNode, status messageStatus, resp proto.Message, err error)
+	var start func(node *messageNode)
+	start = func(node *messageNode) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if resp, err := serviceMessageKind(node.msg, ctxt); err != nil {
+				finish(node, _MESSAGE_FAILED, nil, err)
+			} else {
+				finish(node, _MESSAGE_SUCCEEDED, resp, nil)
+			}
+		}()
+	}
+	finish = func(node *messageNode, status messageStatus, resp proto.Message, err error) {
+		resultsLock.Lock()
+		results[node.index] = &messageResult{
+			id:     messageId(node.msg, node.index),
+			kind:   node.msg.Kind,
+			status: status,
+			err:    err,
+			resp:   resp,
+		}
+		var ready, skipped []*messageNode
+		skipErr := errors.New("dependency " + results[node.index].id + " " + status.String())
+		for _, dependent := range node.dependents {
+			if status != _MESSAGE_SUCCEEDED {
+				if results[dependent.index] == nil {
+					results[dependent.index] = &messageResult{status: _MESSAGE_SKIPPED}
+					skipped = append(skipped, dependent)
+				}
+			} else if remaining[dependent.index]--; remaining[dependent.index] == 0 &&
+				results[dependent.index] == nil {
+				ready = append(ready, dependent)
+			}
+		}
+		resultsLock.Unlock()
+		for _, dependent := range skipped {
+			finish(dependent, _MESSAGE_SKIPPED, nil, skipErr)
+		}
+		for _, dependent := range ready {
+			start(dependent)
+		}
+	}
+
+	var roots []*messageNode
+	for i, node := range nodes {
+		remaining[i] = len(node.deps)
+		if remaining[i] == 0 {
+			roots = append(roots, node)
+		}
+	}
+	for _, node := range roots {
+		start(node)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// printMessageResults writes a summary table of the message results.
+func printMessageResults(w io.Writer, results []*messageResult) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tKIND\tSTATUS\tDETAIL")
+	counts := make(map[messageStatus]int)
+	for _, result := range results {
+		detail := ""
+		if result.err != nil {
+			detail = result.err.Error()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.id, result.kind, result.status, detail)
+		counts[result.status]++
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "%d succeeded, %d failed, %d skipped\n", counts[_MESSAGE_SUCCEEDED],
+		counts[_MESSAGE_FAILED], counts[_MESSAGE_SKIPPED])
+}
+
+// messageResultsResponses returns the responses of the succeeded messages as
+// api messages identified by the id of the message they respond to. Empty
+// responses are omitted.
+func messageResultsResponses(results []*messageResult) ([]*ApiProtoMessage, error) {
+	var responses []*ApiProtoMessage
+	for _, result := range results {
+		if result.resp == nil {
+			continue
+		} else if _, ok := result.resp.(*types.Empty); ok {
+			continue
+		}
+		if kind, version, _, err := marshalKind(result.resp); err != nil {
+			return nil, err
+		} else {
+			responses = append(responses, &ApiProtoMessage{
+				Kind:    kind,
+				Version: version,
+				Def:     result.resp,
+				Id:      result.id,
+			})
+		}
+	}
+	return responses, nil
+}
+
+// messageResultsError returns an error describing the failed messages, or nil
+// if all messages succeeded.
+func messageResultsError(results []*messageResult) error {
+	var failedIds []string
+	for _, result := range results {
+		if result.status != _MESSAGE_SUCCEEDED {
+			failedIds = append(failedIds, result.id)
+		}
+	}
+	if len(failedIds) > 0 {
+		return errors.New("messages not completed: " + strings.Join(failedIds, ", "))
+	}
+	return nil
+}
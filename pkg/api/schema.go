@@ -0,0 +1,379 @@
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"gopkg.in/yaml.v3"
+)
+
+// Name of the message type that holds a single API message document.
+const _API_MESSAGE_TYPE_NAME = "api.ApiMessage"
+
+// Prefix of the names of well-known protobuf types, which are not checked.
+const _WELL_KNOWN_TYPE_PREFIX = "google.protobuf."
+
+// SchemaError describes a single problem found checking an API message
+// document against the proto descriptor of its kind.
+type SchemaError struct {
+	Filename string
+	Document int
+	Line     int
+	Column   int
+	Message  string
+}
+
+func (e *SchemaError) Error() string {
+	if e.Document == 0 {
+		return fmt.Sprintf("%s:%d:%d: %s", e.Filename, e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s (document %d)", e.Filename, e.Line, e.Column,
+		e.Message, e.Document)
+}
+
+// SchemaErrors holds all problems found checking the documents of a file.
+type SchemaErrors []*SchemaError
+
+func (e SchemaErrors) Error() string {
+	var lines []string
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// schemaChecker accumulates the problems found checking the documents of a file.
+type schemaChecker struct {
+	filename  string
+	nodeFiles map[*yaml.Node]string
+	document  int
+	errs      SchemaErrors
+}
+
+// checkDocuments checks each API message document node against the proto
+// descriptor of its kind and returns all problems found, or nil if none. Nodes
+// included from other files are reported with the file names in nodeFiles, and
+// their relative path values are resolved against the directories of those files.
+func checkDocuments(filename string, docs []*yaml.Node, nodeFiles map[*yaml.Node]string) error {
+	checker := &schemaChecker{filename: filename, nodeFiles: nodeFiles}
+	for i, doc := range docs {
+		checker.document = i + 1
+		checker.checkDocument(doc)
+	}
+	if len(checker.errs) > 0 {
+		return checker.errs
+	}
+	return nil
+}
+
+// addError records a problem found at the position of the specified node.
+func (c *schemaChecker) addError(node *yaml.Node, format string, args ...interface{}) {
+	filename := c.filename
+	if nodeFilename, ok := c.nodeFiles[node]; ok {
+		filename = nodeFilename
+	}
+	c.errs = append(c.errs, &SchemaError{
+		Filename: filename,
+		Document: c.document,
+		Line:     node.Line,
+		Column:   node.Column,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// checkDocument checks the kind, version, and def of a single API message.
+func (c *schemaChecker) checkDocument(doc *yaml.Node) {
+	node := resolveNode(doc)
+	if node.Kind != yaml.MappingNode {
+		c.addError(node, "expected mapping for %s", _API_MESSAGE_TYPE_NAME)
+		return
+	}
+	values := make(map[string]*yaml.Node)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], resolveNode(node.Content[i+1])
+		switch keyNode.Value {
+		default:
+			c.addError(keyNode, "unknown field %q in %s", keyNode.Value, _API_MESSAGE_TYPE_NAME)
+			continue
+		case "kind", "version", "def", "id":
+		case "dependsOn", "depends_on":
+			if valueNode.Kind != yaml.SequenceNode {
+				c.addError(valueNode, "expected sequence for field %q in %s", keyNode.Value, _API_MESSAGE_TYPE_NAME)
+			} else {
+				for _, depNode := range valueNode.Content {
+					if !isScalarTag(resolveNode(depNode), "!!str") {
+						c.addError(depNode, "expected string in field %q in %s", keyNode.Value, _API_MESSAGE_TYPE_NAME)
+					}
+				}
+			}
+		}
+		if _, ok := values[keyNode.Value]; ok {
+			c.addError(keyNode, "duplicate field %q in %s", keyNode.Value, _API_MESSAGE_TYPE_NAME)
+		}
+		values[keyNode.Value] = valueNode
+	}
+	ok := true
+	for _, name := range []string{"kind", "version", "def"} {
+		if _, found := values[name]; !found {
+			c.addError(node, "missing field %q in %s", name, _API_MESSAGE_TYPE_NAME)
+			ok = false
+		}
+	}
+	for _, name := range []string{"kind", "version", "id"} {
+		if valueNode, found := values[name]; found && !isScalarTag(valueNode, "!!str") {
+			c.addError(valueNode, "expected string for field %q in %s", name, _API_MESSAGE_TYPE_NAME)
+			ok = false
+		}
+	}
+	if !ok {
+		return
+	}
+	kindNode, defNode := values["kind"], values["def"]
+	protoMsg, err := unmarshalKind(kindNode.Value, values["version"].Value, nil)
+	if err != nil {
+		c.addError(kindNode, "%s", err.Error())
+		return
+	}
+	descMsg, ok := protoMsg.(descriptor.Message)
+	if !ok {
+		c.addError(kindNode, "no descriptor for kind: %s", kindNode.Value)
+		return
+	}
+	if defNode.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(defNode.Content); i += 2 {
+			if keyNode, valueNode := defNode.Content[i], defNode.Content[i+1]; keyNode.Value == "@type" &&
+				valueNode.Value != kindNode.Value && valueNode.Value != kindTypeUrl(kindNode.Value, values["version"].Value) {
+				c.addError(valueNode, "kind/type mismatch: %s", valueNode.Value)
+			}
+		}
+	}
+	c.checkMessage(defNode, descMsg)
+}
+
+// checkMessage checks that the node holds the fields of the message type.
+func (c *schemaChecker) checkMessage(node *yaml.Node, msg descriptor.Message) {
+	node = resolveNode(node)
+	if isNull(node) {
+		return
+	}
+	msgName := proto.MessageName(msg)
+	if node.Kind != yaml.MappingNode {
+		c.addError(node, "expected mapping for %s", msgName)
+		return
+	}
+	_, msgDesc := descriptor.ForMessage(msg)
+	fields := make(map[string]*descriptor.FieldDescriptorProto)
+	for _, field := range msgDesc.GetField() {
+		fields[field.GetName()] = field
+		fields[jsonFieldName(field)] = field
+	}
+	seen := make(map[*descriptor.FieldDescriptorProto]bool)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+		if keyNode.Value == "@type" {
+			continue
+		}
+		field, ok := fields[keyNode.Value]
+		if !ok {
+			c.addError(keyNode, "unknown field %q in %s", keyNode.Value, msgName)
+			continue
+		}
+		if seen[field] {
+			c.addError(keyNode, "duplicate field %q in %s", keyNode.Value, msgName)
+		}
+		seen[field] = true
+		c.checkField(valueNode, field, msgName)
+	}
+}
+
+// checkField checks that the node holds a valid value of the message field.
+func (c *schemaChecker) checkField(node *yaml.Node, field *descriptor.FieldDescriptorProto, msgName string) {
+	node = resolveNode(node)
+	if isNull(node) {
+		return
+	}
+	if !field.IsRepeated() {
+		c.checkValue(node, field, msgName)
+		return
+	}
+	if entryDesc := mapEntryDescriptor(field); entryDesc != nil {
+		if node.Kind != yaml.MappingNode {
+			c.addError(node, "expected mapping for field %q in %s", jsonFieldName(field), msgName)
+			return
+		}
+		for _, entryField := range entryDesc.GetField() {
+			if entryField.GetNumber() == 2 {
+				for i := 1; i < len(node.Content); i += 2 {
+					c.checkValue(resolveNode(node.Content[i]), entryField, msgName)
+				}
+			}
+		}
+		return
+	}
+	if node.Kind != yaml.SequenceNode {
+		c.addError(node, "expected sequence for field %q in %s", jsonFieldName(field), msgName)
+		return
+	}
+	for _, elemNode := range node.Content {
+		c.checkValue(resolveNode(elemNode), field, msgName)
+	}
+}
+
+// checkValue checks that the node holds a single valid value of the field type.
+func (c *schemaChecker) checkValue(node *yaml.Node, field *descriptor.FieldDescriptorProto, msgName string) {
+	if isNull(node) {
+		return
+	}
+	typeName := strings.TrimPrefix(field.GetTypeName(), ".")
+	expected := ""
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		if strings.HasPrefix(typeName, _WELL_KNOWN_TYPE_PREFIX) {
+			return
+		}
+		msgType := proto.MessageType(typeName)
+		if msgType == nil {
+			c.addError(node, "unknown message type %s for field %q in %s", typeName, jsonFieldName(field), msgName)
+		} else if fieldMsg, ok := reflect.New(msgType.Elem()).Interface().(descriptor.Message); !ok {
+			c.addError(node, "no descriptor for message type %s", typeName)
+		} else {
+			c.checkMessage(node, fieldMsg)
+		}
+		return
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if isScalarTag(node, "!!int") {
+			return
+		} else if node.Kind != yaml.ScalarNode {
+			expected = "enum " + typeName
+		} else if _, ok := proto.EnumValueMap(typeName)[node.Value]; !ok {
+			c.addError(node, "invalid value %q for enum %s in field %q in %s", node.Value, typeName,
+				jsonFieldName(field), msgName)
+		}
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		if !isScalarTag(node, "!!bool") {
+			expected = "boolean"
+		}
+	case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_BYTES:
+		if !isScalarTag(node, "!!str") {
+			expected = "string"
+		} else if isPathField(msgName, jsonFieldName(field)) {
+			rebaseIncludedPath(node, c.filename, c.nodeFiles)
+		}
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		if !isScalarTag(node, "!!float") && !isScalarTag(node, "!!int") {
+			expected = "number"
+		}
+	default:
+		if !isIntegerValue(node, field.GetType()) {
+			expected = "integer"
+		}
+	}
+	if expected != "" {
+		c.addError(node, "expected %s for field %q in %s", expected, jsonFieldName(field), msgName)
+	}
+}
+
+// isIntegerValue returns whether the node is an integer in the range of the
+// integer field type, either as a yaml integer or as a string of decimal digits
+// as jsonpb also accepts for 64-bit integers.
+func isIntegerValue(node *yaml.Node, fieldType descriptor.FieldDescriptorProto_Type) bool {
+	base := 10
+	if isScalarTag(node, "!!int") {
+		base = 0
+	} else if !isScalarTag(node, "!!str") {
+		return false
+	}
+	bitSize := 64
+	switch fieldType {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		bitSize = 32
+	}
+	var err error
+	switch fieldType {
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		_, err = strconv.ParseUint(node.Value, base, bitSize)
+	default:
+		_, err = strconv.ParseInt(node.Value, base, bitSize)
+	}
+	return err == nil
+}
+
+// mapEntryDescriptor returns the descriptor of the entries of a map field, or
+// nil if the field is not a map.
+func mapEntryDescriptor(field *descriptor.FieldDescriptorProto) *descriptor.DescriptorProto {
+	if !field.IsMessage() {
+		return nil
+	}
+	// Map entry types are nested in the message containing the field.
+	typeName := strings.TrimPrefix(field.GetTypeName(), ".")
+	dot := strings.LastIndex(typeName, ".")
+	if dot < 0 {
+		return nil
+	}
+	parentType := proto.MessageType(typeName[:dot])
+	if parentType == nil {
+		return nil
+	}
+	parentMsg, ok := reflect.New(parentType.Elem()).Interface().(descriptor.Message)
+	if !ok {
+		return nil
+	}
+	_, parentDesc := descriptor.ForMessage(parentMsg)
+	for _, nestedDesc := range parentDesc.GetNestedType() {
+		if nestedDesc.GetName() == typeName[dot+1:] && nestedDesc.GetOptions().GetMapEntry() {
+			return nestedDesc
+		}
+	}
+	return nil
+}
+
+// jsonFieldName returns the name of the field used by the json mapping.
+func jsonFieldName(field *descriptor.FieldDescriptorProto) string {
+	if field.GetJsonName() != "" {
+		return field.GetJsonName()
+	}
+	var name strings.Builder
+	upper := false
+	for _, ch := range field.GetName() {
+		if ch == '_' {
+			upper = true
+		} else if upper {
+			name.WriteString(strings.ToUpper(string(ch)))
+			upper = false
+		} else {
+			name.WriteRune(ch)
+		}
+	}
+	return name.String()
+}
+
+// resolveNode returns the node an alias or document node refers to.
+func resolveNode(node *yaml.Node) *yaml.Node {
+	for {
+		switch {
+		case node.Kind == yaml.AliasNode && node.Alias != nil:
+			node = node.Alias
+		case node.Kind == yaml.DocumentNode && len(node.Content) == 1:
+			node = node.Content[0]
+		default:
+			return node
+		}
+	}
+}
+
+// isNull returns whether the node holds a null value.
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null"
+}
+
+// isScalarTag returns whether the node is a scalar with the specified tag.
+func isScalarTag(node *yaml.Node, tag string) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == tag
+}
@@ -5,159 +5,647 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
+// Prefix of context addresses that refer to unix domain sockets.
+const _UNIX_ADDR_PREFIX = "unix:"
+
+// Default file permission bits of unix domain sockets created for API servers.
+const _DEFAULT_SOCKET_MODE = 0600
+
+// Maximum time to wait for an existing socket to accept a connection before
+// it is considered stale.
+const _SOCKET_PROBE_TIMEOUT = time.Second
+
 // ApiServiceMessage interface represents the common values of all service messages.
 type ApiServiceMessage interface {
 	GetApiHostname() string
 	GetApiPort() uint32
 	GetApiTimeout() uint32
+	GetApiSocket() string
+	GetApiTlsCaFile() string
+	GetApiTlsCertFile() string
+	GetApiTlsKeyFile() string
+	GetApiRetries() uint32
+	GetApiAuthTokenFile() string
+}
+
+// apiSocketModeMessage interface represents service messages that can
+// specify permissions for a created unix domain socket.
+type apiSocketModeMessage interface {
+	GetApiSocketMode() uint32
 }
 
-// ApiServiceContext holds runtime context information for OS API services.
+// ApiServiceContext holds runtime context information for OS API services. The
+// servers and clients of the context are created and stopped concurrently, so
+// they are only accessible through methods of the context.
 type ApiServiceContext struct {
-	MessageQueue      []*ApiProtoMessage
-	AddrGrpcServerMap map[string]*grpc.Server
-	AddrGrpcConnMap   map[string]*grpc.ClientConn
-	AddrKindVerMap    map[string]string
-	AddrClientMap     map[string]interface{}
-	AddrServerMap     map[string]interface{}
-	AddrStopSignalMap map[string]func()
-	AddrStopWaitMap   map[string]func() error
-	RespHandlerMap    map[string]func(interface{}) error
-	KindImplMap       map[string]interface{}
-	ServerWg          *sync.WaitGroup
+	MessageQueue []*ApiProtoMessage
+	ServerWg     *sync.WaitGroup
+	OutputFile   string // Where to write responses, "-" for stdout, or empty for none.
+	OutputFormat string // Format to write responses in, inferred from OutputFile if empty.
+
+	// Interceptors chained on each gRPC server and client created for the context.
+	UnaryServerInterceptors  []grpc.UnaryServerInterceptor
+	StreamServerInterceptors []grpc.StreamServerInterceptor
+	UnaryClientInterceptors  []grpc.UnaryClientInterceptor
+	StreamClientInterceptors []grpc.StreamClientInterceptor
+
+	// Backoff between attempts to connect to servers and retries of requests.
+	RetryBackoff backoff.Config
+
+	kindImplMap    map[string]interface{}             // Read-only after InitContext.
+	respHandlerMap map[string]func(interface{}) error // Read-only after InitContext.
+	internalToken  string                             // Identifies clients to servers of the context.
+
+	mu       sync.Mutex               // Guards addrs, journals and dialers.
+	addrs    map[string]*apiAddr      // The servers and clients of the context by address.
+	journals map[string]*auditJournal // Audit journals by service kind.
+	dialers  map[string]apiDialer     // Dialers of client connections by address.
+}
+
+// apiDialer connects to the server at a dial target.
+type apiDialer func(ctx context.Context, target string) (net.Conn, error)
+
+// apiAddr holds the server and client connection of the context at an
+// address. Several services may be served and called at the same address.
+type apiAddr struct {
+	grpcServer *grpc.Server
+	health     *health.Server
+	served     map[string]string         // Served service kinds to their versions.
+	policies   map[string]*apiAuthPolicy // Authorization policies by served service kind.
+	stopped    chan struct{}             // Closed once no service remains served.
+	gateway    *apiGateway               // HTTP/JSON gateway to the server, if any.
+	grpcConn   *grpc.ClientConn
+	clients    map[string]interface{} // Clients by service kind/version.
 }
 
 // InitContext initializes a new context for api services.
 func InitContext(kindImplMap map[string]interface{}, respHandlerMap map[string]func(interface{}) error) *ApiServiceContext {
 	return &ApiServiceContext{
-		AddrGrpcServerMap: make(map[string]*grpc.Server),
-		AddrGrpcConnMap:   make(map[string]*grpc.ClientConn),
-		AddrKindVerMap:    make(map[string]string),
-		AddrClientMap:     make(map[string]interface{}),
-		AddrServerMap:     make(map[string]interface{}),
-		AddrStopSignalMap: make(map[string]func()),
-		AddrStopWaitMap:   make(map[string]func() error),
-		RespHandlerMap:    respHandlerMap,
-		KindImplMap:       kindImplMap,
-		ServerWg:          &sync.WaitGroup{},
+		ServerWg:       &sync.WaitGroup{},
+		RetryBackoff:   DefaultRetryBackoff,
+		kindImplMap:    kindImplMap,
+		respHandlerMap: respHandlerMap,
+		internalToken:  newInternalToken(),
+		addrs:          make(map[string]*apiAddr),
+		journals:       make(map[string]*auditJournal),
+		dialers:        make(map[string]apiDialer),
+	}
+}
+
+// SetContextDialer makes clients of the context connect to the address with
+// the dialer, e.g. to reach an in-memory server in tests. Applies to clients
+// connected after it is called.
+func (ctxt *ApiServiceContext) SetContextDialer(addr string, dialer func(context.Context, string) (net.Conn, error)) {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	ctxt.dialers[addr] = dialer
+}
+
+// SignalStop stops serving the service kind at the address. Once no service
+// remains served, the server at the address stops after all messages of the
+// queue are processed.
+func (ctxt *ApiServiceContext) SignalStop(addr, kind string) error {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if a, ok := ctxt.addrs[addr]; !ok || a.grpcServer == nil {
+		return errors.New("no server at " + addr)
+	} else {
+		a.unserve(kind)
+	}
+	return nil
+}
+
+// ServerAddrs returns the sorted addresses of the servers of the context.
+func (ctxt *ApiServiceContext) ServerAddrs() []string {
+	return ctxt.sortedAddrs(func(a *apiAddr) bool { return a.grpcServer != nil })
+}
+
+// ClientAddrs returns the sorted addresses of the clients of the context.
+func (ctxt *ApiServiceContext) ClientAddrs() []string {
+	return ctxt.sortedAddrs(func(a *apiAddr) bool { return a.grpcConn != nil })
+}
+
+// ServedKindVers returns the sorted kind/version of each service served at the address.
+func (ctxt *ApiServiceContext) ServedKindVers(addr string) []string {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	var kindVers []string
+	if a, ok := ctxt.addrs[addr]; ok {
+		for kind, version := range a.served {
+			kindVers = append(kindVers, kind+"/"+version)
+		}
+	}
+	sort.Strings(kindVers)
+	return kindVers
+}
+
+// KindImpl returns the server implementation of the service kind/version.
+func (ctxt *ApiServiceContext) KindImpl(kindVer string) (interface{}, bool) {
+	impl, ok := ctxt.kindImplMap[kindVer]
+	return impl, ok
+}
+
+// implVersion returns the latest version of the service kind with an impl in
+// the context, which serves the calls of every version of the service.
+func (ctxt *ApiServiceContext) implVersion(kind string) (string, bool) {
+	var versions []string
+	for implKindVer := range ctxt.kindImplMap {
+		if implKind, implVersion := splitKindVersion(implKindVer); implKind == kind {
+			versions = append(versions, implVersion)
+		}
+	}
+	return latestVersion(versions), len(versions) > 0
+}
+
+// sortedAddrs returns the sorted addresses of the context matching the filter.
+func (ctxt *ApiServiceContext) sortedAddrs(filter func(a *apiAddr) bool) []string {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	var addrs []string
+	for addr, a := range ctxt.addrs {
+		if filter(a) {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// isServed returns whether the service kind is served at the address.
+func (ctxt *ApiServiceContext) isServed(addr, kind string) bool {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if a, ok := ctxt.addrs[addr]; ok {
+		_, ok = a.served[kind]
+		return ok
+	}
+	return false
+}
+
+// unserve stops serving the service kind, and signals that the server can stop
+// once no service remains served. The caller must hold the context lock.
+func (a *apiAddr) unserve(kind string) {
+	if _, ok := a.served[kind]; !ok {
+		return
+	}
+	delete(a.served, kind)
+	delete(a.policies, kind)
+	a.health.SetServingStatus(kind, healthpb.HealthCheckResponse_NOT_SERVING)
+	if len(a.served) == 0 {
+		a.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+		close(a.stopped)
+	}
+}
+
+// releaseAddr removes the address from the context once it has neither a
+// server nor a client. The caller must hold the context lock.
+func (ctxt *ApiServiceContext) releaseAddr(addr string) {
+	if a, ok := ctxt.addrs[addr]; ok && a.grpcServer == nil && a.grpcConn == nil {
+		delete(ctxt.addrs, addr)
 	}
 }
 
 // ServiceMessages processes each message in the queue and returns only when all
 // tasks have stopped. Therefore, if a message starts serving a new server
 // without a later message unserving it, the function will not return until the
-// server stops by some other means.
+// server stops by some other means. Messages are processed in dependency order,
+// concurrently where possible, and a summary of the results is printed. The
+// responses are written to the output file of the context if set.
 func ServiceMessages(ctxt *ApiServiceContext) error {
-	return serviceMessageKinds(ctxt)
+	results, err := serviceMessageGraph(ctxt)
+	if err != nil {
+		return err
+	}
+	if len(results) > 0 {
+		printMessageResults(os.Stderr, results)
+	}
+	if ctxt.OutputFile != "" {
+		if responses, err := messageResultsResponses(results); err != nil {
+			return err
+		} else if err := MarshalApiProtoMessages(responses, ctxt.OutputFile, ctxt.OutputFormat); err != nil {
+			return err
+		}
+	}
+	if err := messageResultsError(results); err != nil {
+		return err
+	}
+	for _, addr := range ctxt.ServerAddrs() {
+		if err := waitStop(addr, ctxt); err != nil {
+			return err
+		}
+	}
+	ctxt.ServerWg.Wait()
+	return nil
+}
+
+// RequestApiProtoMessage sends a single request message to its server with the
+// client of its kind and returns the response, or nil if the response is empty.
+// Requests to serve are not accepted since they would start a server.
+func RequestApiProtoMessage(msg *ApiProtoMessage, ctxt *ApiServiceContext) (*ApiProtoMessage, error) {
+	if strings.HasSuffix(msg.Kind, ".ApiServeRequest") {
+		return nil, errors.New("cannot request serving: " + msg.Kind)
+	}
+	results := []*messageResult{{id: msg.Id}}
+	if resp, err := serviceMessageKind(msg, ctxt); err != nil {
+		return nil, err
+	} else {
+		results[0].resp = resp
+	}
+	if responses, err := messageResultsResponses(results); err != nil || len(responses) == 0 {
+		return nil, err
+	} else {
+		return responses[0], nil
+	}
+}
+
+// MessageAddr returns the context address of the API server the message
+// operates on. The address is the unix domain socket path prefixed with
+// "unix:" if a socket is specified, or hostname:port otherwise.
+func MessageAddr(msg ApiServiceMessage) string {
+	if msg.GetApiSocket() != "" {
+		return _UNIX_ADDR_PREFIX + filepath.Clean(msg.GetApiSocket())
+	}
+	return fmt.Sprintf("%s:%d", msg.GetApiHostname(), msg.GetApiPort())
 }
 
 // makeClientGrpcContextForMsg creates a new client for the api message if one doesn't
-// already exist, then initializes a new grpc context for a grpc call.
+// already exist, then initializes a new grpc context for a grpc call. Returns the
+// address and the client for it. The timeout of the message applies to each
+// attempt of the call rather than to the context, and the context of a message
+// without a timeout has a default deadline.
 func makeClientGrpcContextForMsg(kind, version string, msg ApiServiceMessage,
-	ctxt *ApiServiceContext) (string, context.Context, context.CancelFunc, error) {
+	ctxt *ApiServiceContext) (string, interface{}, context.Context, context.CancelFunc, error) {
 
-	addr := fmt.Sprintf("%s:%d", msg.GetApiHostname(), msg.GetApiPort())
-	if _, ok := ctxt.AddrClientMap[addr]; !ok {
-		if err := newClient(kind, version, addr, ctxt); err != nil {
-			return "", nil, nil, err
+	addr := MessageAddr(msg)
+	kindVer := kind + "/" + version
+	ctxt.mu.Lock()
+	if a, ok := ctxt.addrs[addr]; !ok || a.clients[kindVer] == nil {
+		if err := newClient(kind, version, addr, msg, ctxt); err != nil {
+			ctxt.mu.Unlock()
+			return "", nil, nil, nil, err
 		}
 	}
-	grpcContext, grpcCancel := context.WithTimeout(context.Background(),
-		time.Duration(msg.GetApiTimeout())*time.Second)
-	return addr, grpcContext, grpcCancel, nil
+	client := ctxt.addrs[addr].clients[kindVer]
+	ctxt.mu.Unlock()
+	if msg.GetApiTimeout() == 0 {
+		grpcContext, grpcCancel := context.WithTimeout(context.Background(), _DEFAULT_CALL_TIMEOUT)
+		return addr, client, grpcContext, grpcCancel, nil
+	}
+	grpcContext, grpcCancel := context.WithCancel(context.Background())
+	return addr, client, grpcContext, grpcCancel, nil
 }
 
-// newServer creates a new gRPC server and stores it in the
-// context map with listening address as the key to the map.
-func newServer(kind, version string, msg ApiServiceMessage, ctxt *ApiServiceContext) error {
-	addr := fmt.Sprintf("%s:%d", msg.GetApiHostname(), msg.GetApiPort())
-	chStop := make(chan bool, 1)
-	if _, ok := ctxt.AddrServerMap[addr]; ok {
-		return errors.New("already exists: " + addr)
+// newServer starts serving the latest implemented version of the service kind
+// at the listening address of the message, which converts calls of other
+// versions. A new gRPC server is created for the address unless another
+// service is already served there, in which case the server options of the
+// message are ignored. A new server has an HTTP/JSON gateway if the message
+// specifies a gateway port.
+func newServer(kind string, msg ApiServiceMessage, ctxt *ApiServiceContext) error {
+	addr := MessageAddr(msg)
+	version, ok := ctxt.implVersion(kind)
+	if !ok {
+		return errors.New("no impl for kind: " + kind)
 	}
-	if listener, err := net.Listen("tcp", addr); err != nil {
+	kindVer := kind + "/" + version
+	policy, err := loadAuthPolicy(msg)
+	if err != nil {
 		return err
-	} else {
-		ctxt.AddrGrpcServerMap[addr] = grpc.NewServer()
-		ctxt.AddrKindVerMap[addr] = kind + "/" + version
-		ctxt.AddrStopSignalMap[addr] = func() { chStop <- true }
-		if err := makeServerKind(addr, ctxt); err != nil {
+	}
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	a, ok := ctxt.addrs[addr]
+	if ok && a.grpcServer != nil {
+		if _, ok := a.served[kind]; ok {
+			return errors.New("already exists: " + kindVer + " at " + addr)
+		} else if len(a.served) == 0 {
+			return errors.New("server stopping: " + addr)
+		}
+		a.served[kind] = version
+		a.policies[kind] = policy
+		a.health.SetServingStatus(kind, healthpb.HealthCheckResponse_SERVING)
+		return nil
+	}
+	socketMode := os.FileMode(_DEFAULT_SOCKET_MODE)
+	if modeMsg, ok := msg.(apiSocketModeMessage); ok && modeMsg.GetApiSocketMode() != 0 {
+		socketMode = os.FileMode(modeMsg.GetApiSocketMode()) & os.ModePerm
+	}
+	serverOpts, err := serverCredsOptions(msg)
+	if err != nil {
+		return err
+	}
+	implKinds := make(map[string]bool)
+	for implKindVer := range ctxt.kindImplMap {
+		implKind, _ := splitKindVersion(implKindVer)
+		implKinds[implKind] = true
+	}
+	servedCheck := func(kind string) bool { return !implKinds[kind] || ctxt.isServed(addr, kind) }
+	// Audit and authorize calls after the context interceptors so that rejected
+	// calls are handled by them too, then validate the requests of authorized
+	// calls.
+	unaryInterceptors := append([]grpc.UnaryServerInterceptor{gatewayUnaryInterceptor(ctxt),
+		servedUnaryInterceptor(servedCheck)}, ctxt.UnaryServerInterceptors...)
+	streamInterceptors := append([]grpc.StreamServerInterceptor{gatewayStreamInterceptor(ctxt),
+		servedStreamInterceptor(servedCheck)}, ctxt.StreamServerInterceptors...)
+	serverOpts = append(serverOpts,
+		grpc.ChainUnaryInterceptor(append(unaryInterceptors, auditUnaryInterceptor(ctxt),
+			authorizeUnaryInterceptor(addr, ctxt), validateUnaryInterceptor())...),
+		grpc.ChainStreamInterceptor(append(streamInterceptors, authorizeStreamInterceptor(addr, ctxt))...))
+	grpcServer := grpc.NewServer(serverOpts...)
+	// Register every implemented service, since services cannot be registered
+	// once serving. Calls to services not yet served are rejected.
+	registered := make(map[string]string)
+	implKindList := make([]string, 0, len(implKinds))
+	for implKind := range implKinds {
+		implKindList = append(implKindList, implKind)
+	}
+	sort.Strings(implKindList)
+	for _, implKind := range implKindList {
+		implVersion, _ := ctxt.implVersion(implKind)
+		implKindVer := implKind + "/" + implVersion
+		if _, err := makeServerKind(implKindVer, grpcServer, ctxt.kindImplMap[implKindVer]); err != nil {
 			return err
 		}
-		ctxt.ServerWg.Add(1)
-		go func() {
-			if err := ctxt.AddrGrpcServerMap[addr].Serve(listener); err != nil {
-				fmt.Println("Error serving " + addr + ": " + err.Error())
-			}
-			ctxt.ServerWg.Done()
-		}()
-		ctxt.AddrStopWaitMap[addr] = func() error {
-			stop, ok := <-chStop
-			if stop && ok {
-				if err := closeClient(addr, ctxt); err != nil {
-					return err
-				}
-				if err := stopServer(addr, ctxt); err != nil {
-					return err
-				}
-			}
-			return nil
+		registered[implKind] = implVersion
+	}
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(kind, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	registerReflection(grpcServer, servedCheck)
+	gateway, err := newGateway(addr, msg, grpcServer, registered, ctxt)
+	if err != nil {
+		return err
+	}
+	listener, err := listen(addr, socketMode)
+	if err != nil {
+		if gateway != nil {
+			gateway.stop()
 		}
+		return err
+	}
+	if a == nil {
+		a = &apiAddr{}
+		ctxt.addrs[addr] = a
+	}
+	a.grpcServer = grpcServer
+	a.health = healthServer
+	a.served = map[string]string{kind: version}
+	a.policies = map[string]*apiAuthPolicy{kind: policy}
+	a.stopped = make(chan struct{})
+	a.gateway = gateway
+	ctxt.ServerWg.Add(1)
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			fmt.Println("Error serving " + addr + ": " + err.Error())
+		}
+		ctxt.ServerWg.Done()
+	}()
+	if gateway != nil {
+		gateway.start(ctxt)
 	}
 	return nil
 }
 
-// newClient creates a new gRPC client for the specified
-// address and stores it in the context map.
-func newClient(kind, version, addr string, ctxt *ApiServiceContext) error {
-	if _, ok := ctxt.AddrClientMap[addr]; ok {
-		return errors.New("already exists: " + addr)
+// waitStop waits until no service remains served at the address, then closes
+// the client connection and stops the server.
+func waitStop(addr string, ctxt *ApiServiceContext) error {
+	ctxt.mu.Lock()
+	a, ok := ctxt.addrs[addr]
+	if !ok || a.grpcServer == nil {
+		ctxt.mu.Unlock()
+		return nil
 	}
-	creds := insecure.NewCredentials() // No TLS, localhost assumed.
-	if conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds)); err != nil {
+	stopped := a.stopped
+	ctxt.mu.Unlock()
+	<-stopped
+	if err := closeClient(addr, ctxt); err != nil {
 		return err
-	} else {
-		if kindVer, ok := ctxt.AddrKindVerMap[addr]; ok && kindVer != kind+"/"+version {
-			return errors.New("client kind mismatch")
+	}
+	return stopServer(addr, "", ctxt)
+}
+
+// newClient creates a new gRPC client of the service kind for the specified
+// address and stores it in the context. The clients of all services at an
+// address share a connection, which is made using the transport credentials of
+// the first message. The caller must hold the context lock.
+func newClient(kind, version, addr string, msg ApiServiceMessage, ctxt *ApiServiceContext) error {
+	kindVer := kind + "/" + version
+	a, ok := ctxt.addrs[addr]
+	if ok && a.clients[kindVer] != nil {
+		return errors.New("already exists: " + kindVer + " at " + addr)
+	}
+	if a == nil || a.grpcConn == nil {
+		creds, err := clientCreds(addr, msg)
+		if err != nil {
+			return err
+		}
+		target, targetOpts := DialTarget(addr)
+		if dialer, ok := ctxt.dialers[addr]; ok {
+			target, targetOpts = "passthrough:///"+addr, []grpc.DialOption{grpc.WithContextDialer(dialer)}
 		}
-		ctxt.AddrGrpcConnMap[addr] = conn
-		ctxt.AddrKindVerMap[addr] = kind + "/" + version
-		if err := makeClientKind(addr, ctxt); err != nil {
+		dialOpts := append(clientDialOptions(addr, ctxt), targetOpts...)
+		conn, err := grpc.Dial(target, append(dialOpts, grpc.WithTransportCredentials(creds))...)
+		if err != nil {
 			return err
 		}
+		if a == nil {
+			a = &apiAddr{}
+			ctxt.addrs[addr] = a
+		}
+		a.grpcConn = conn
+		a.clients = make(map[string]interface{})
+	}
+	client, err := makeClientKind(kindVer, a.grpcConn)
+	if err != nil {
+		return err
 	}
+	a.clients[kindVer] = client
 	return nil
 }
 
-// closeClient closes the client for the specified address and
-// removes it from the context.
+// DialTarget returns the gRPC dial target of the specified context address,
+// and the dial options it requires.
+func DialTarget(addr string) (string, []grpc.DialOption) {
+	if !strings.HasPrefix(addr, _UNIX_ADDR_PREFIX) {
+		return addr, nil
+	}
+	socketPath := strings.TrimPrefix(addr, _UNIX_ADDR_PREFIX)
+	return "passthrough:///" + socketPath, []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			dialer := &net.Dialer{}
+			return dialer.DialContext(ctx, "unix", socketPath)
+		}),
+	}
+}
+
+// listen announces on the specified context address. For unix domain socket
+// addresses the socket is created with the specified file permission bits in a
+// directory only the owner can access, then moved into place over any stale
+// socket file. Fails if a server already accepts connections on the socket.
+func listen(addr string, socketMode os.FileMode) (net.Listener, error) {
+	if !strings.HasPrefix(addr, _UNIX_ADDR_PREFIX) {
+		return net.Listen("tcp", addr)
+	}
+	socketPath := strings.TrimPrefix(addr, _UNIX_ADDR_PREFIX)
+	if info, err := os.Lstat(socketPath); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, errors.New("not a socket: " + socketPath)
+		} else if conn, err := net.DialTimeout("unix", socketPath, _SOCKET_PROBE_TIMEOUT); err == nil {
+			conn.Close()
+			return nil, errors.New("socket in use: " + socketPath)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(socketPath), 0755); err != nil {
+		return nil, err
+	}
+	privateDir, err := os.MkdirTemp(filepath.Dir(socketPath), ".socket-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(privateDir)
+	privatePath := filepath.Join(privateDir, filepath.Base(socketPath))
+	listener, err := net.Listen("unix", privatePath)
+	if err != nil {
+		return nil, err
+	}
+	// The socket file is moved, so it is removed on close by its final path.
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	if err := os.Chmod(privatePath, socketMode); err != nil {
+		listener.Close()
+		return nil, err
+	} else if err := os.Rename(privatePath, socketPath); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return &peerCredListener{Listener: &socketListener{Listener: listener, socketPath: socketPath}}, nil
+}
+
+// socketListener is a unix domain socket listener that removes its socket file
+// when closed.
+type socketListener struct {
+	net.Listener
+	socketPath string
+	closeOnce  sync.Once
+}
+
+func (l *socketListener) Close() error {
+	err := l.Listener.Close()
+	l.closeOnce.Do(func() { os.Remove(l.socketPath) })
+	return err
+}
+
+// closeClient closes the client connection for the specified address and
+// removes its clients from the context.
 func closeClient(addr string, ctxt *ApiServiceContext) error {
-	if _, ok := ctxt.AddrClientMap[addr]; !ok {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	a, ok := ctxt.addrs[addr]
+	if !ok || a.grpcConn == nil {
 		return nil
 	}
-	if err := ctxt.AddrGrpcConnMap[addr].Close(); err != nil {
+	if err := a.grpcConn.Close(); err != nil {
 		return err
 	}
-	delete(ctxt.AddrGrpcConnMap, addr)
-	delete(ctxt.AddrClientMap, addr)
+	a.grpcConn, a.clients = nil, nil
+	ctxt.releaseAddr(addr)
 	return nil
 }
 
-// stopServer stops the server at the specified address from listening and
-// removes it from the context.
-func stopServer(addr string, ctxt *ApiServiceContext) error {
-	if _, ok := ctxt.AddrServerMap[addr]; !ok {
+// stopServer stops serving the service kind at the specified address, or no
+// service if kind is empty, and closes its audit journal. Once no service
+// remains served, the server and its gateway stop listening and are removed
+// from the context, and the audit journals of the services unserved from it
+// are closed once their calls complete.
+func stopServer(addr, kind string, ctxt *ApiServiceContext) error {
+	ctxt.mu.Lock()
+	a, ok := ctxt.addrs[addr]
+	if !ok || a.grpcServer == nil {
+		ctxt.mu.Unlock()
 		return nil
 	}
-	ctxt.AddrGrpcServerMap[addr].GracefulStop()
-	delete(ctxt.AddrServerMap, addr)
-	delete(ctxt.AddrGrpcServerMap, addr)
+	a.unserve(kind)
+	if len(a.served) > 0 {
+		ctxt.mu.Unlock()
+		if kind != "" {
+			ctxt.closeAuditJournal(kind)
+		}
+		return nil
+	}
+	grpcServer, healthServer, gateway := a.grpcServer, a.health, a.gateway
+	a.grpcServer, a.health, a.served, a.policies, a.gateway = nil, nil, nil, nil, nil
+	ctxt.releaseAddr(addr)
+	ctxt.mu.Unlock()
+	if gateway != nil {
+		gateway.stop()
+	}
+	healthServer.Shutdown()
+	grpcServer.GracefulStop()
+	ctxt.closeUnservedAuditJournals()
 	return nil
 }
+
+// servedUnaryInterceptor returns a server interceptor that rejects calls to
+// services that are not served.
+func servedUnaryInterceptor(served func(kind string) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if kind := methodServiceKind(info.FullMethod); !served(kind) {
+			return nil, status.Errorf(codes.Unimplemented, "service not served: %s", kind)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// servedStreamInterceptor returns a server interceptor that rejects streams of
+// services that are not served.
+func servedStreamInterceptor(served func(kind string) bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		if kind := methodServiceKind(info.FullMethod); !served(kind) {
+			return status.Errorf(codes.Unimplemented, "service not served: %s", kind)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// validateUnaryInterceptor returns a server interceptor that rejects calls
+// whose requests violate the validation rules of their messages.
+func validateUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if validating, ok := req.(interface{ Validate() error }); ok {
+			if err := validating.Validate(); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %v", info.FullMethod, err)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// methodServiceKind returns the service kind of a full gRPC method name of the
+// form /package.Service/Method.
+func methodServiceKind(fullMethod string) string {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i]
+	}
+	return fullMethod
+}
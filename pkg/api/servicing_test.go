@@ -0,0 +1,115 @@
+package api
+
+import (
+	api_os_container_runtime_v0 "alt-os/api/os/container/runtime/v0"
+	api_os_machine_runtime_v0 "alt-os/api/os/machine/runtime/v0"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// Kinds of the services served by the context tests.
+const (
+	_TEST_VM_KIND = "os.machine.runtime.VmRuntimeService"
+	_TEST_CT_KIND = "os.container.runtime.ContainerRuntimeService"
+)
+
+type testVmRuntimeImpl struct {
+	api_os_machine_runtime_v0.UnimplementedVmRuntimeServiceServer
+}
+
+type testCtRuntimeImpl struct {
+	api_os_container_runtime_v0.UnimplementedContainerRuntimeServiceServer
+}
+
+// TestContextConcurrentServing serves, calls and stops two services at each
+// of several addresses of one context at once, which is meant to be run with
+// -race.
+func TestContextConcurrentServing(t *testing.T) {
+	const addrs = 8
+	ctxt := InitContext(map[string]interface{}{
+		_TEST_VM_KIND + "/v0": &testVmRuntimeImpl{},
+		_TEST_CT_KIND + "/v0": &testCtRuntimeImpl{},
+	}, map[string]func(interface{}) error{})
+	dir := t.TempDir()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 16*addrs)
+	for i := 0; i < addrs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			socket := filepath.Join(dir, fmt.Sprintf("api%d.sock", i))
+			addr := MessageAddr(&api_os_machine_runtime_v0.ApiServeRequest{ApiSocket: socket})
+			if err := newServer(_TEST_VM_KIND, &api_os_machine_runtime_v0.ApiServeRequest{ApiSocket: socket}, ctxt); err != nil {
+				errs <- err
+				return
+			}
+
+			// Serve the other service, call both, and use the journals and
+			// policies of the services at once.
+			var callWg sync.WaitGroup
+			callWg.Add(4)
+			go func() {
+				defer callWg.Done()
+				if err := newServer(_TEST_CT_KIND, &api_os_container_runtime_v0.ApiServeRequest{ApiSocket: socket}, ctxt); err != nil {
+					errs <- err
+				}
+			}()
+			go func() {
+				defer callWg.Done()
+				for j := 0; j < 4; j++ {
+					_, kindClient, ctx, cancel, err := makeClientGrpcContextForMsg(_TEST_VM_KIND, "v0",
+						&api_os_machine_runtime_v0.KillRequest{ApiSocket: socket}, ctxt)
+					if err != nil {
+						errs <- err
+						return
+					}
+					// The impl does not implement Kill, but the call is audited.
+					kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient).Kill(ctx,
+						&api_os_machine_runtime_v0.KillRequest{ApiSocket: socket, Id: "vm0"})
+					cancel()
+				}
+			}()
+			go func() {
+				defer callWg.Done()
+				journalFile := filepath.Join(dir, fmt.Sprintf("journal%d", i), AUDIT_JOURNAL_FILENAME)
+				if err := ctxt.OpenAuditJournal(_TEST_VM_KIND, journalFile); err != nil {
+					errs <- err
+				}
+				ctxt.auditJournal(_TEST_VM_KIND)
+			}()
+			go func() {
+				defer callWg.Done()
+				ctxt.authPolicy(addr, _TEST_VM_KIND)
+				ctxt.ServerAddrs()
+				ctxt.ClientAddrs()
+				ctxt.ServedKindVers(addr)
+			}()
+			callWg.Wait()
+
+			for _, kind := range []string{_TEST_CT_KIND, _TEST_VM_KIND} {
+				if err := ctxt.SignalStop(addr, kind); err != nil {
+					errs <- err
+				}
+			}
+			if err := waitStop(addr, ctxt); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	ctxt.ServerWg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	if addrs := append(ctxt.ServerAddrs(), ctxt.ClientAddrs()...); len(addrs) > 0 {
+		t.Errorf("addresses %v remain after stopping", addrs)
+	}
+	if journal := ctxt.auditJournal(_TEST_VM_KIND); journal != nil {
+		t.Error("audit journal remains open after stopping")
+	}
+}
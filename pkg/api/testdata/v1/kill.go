@@ -0,0 +1,19 @@
+// Package v1 is a version of the os.machine.runtime kinds newer than any in
+// the api, which tests convert messages to and from.
+package v1
+
+import "github.com/gogo/protobuf/proto"
+
+// KillRequest is a newer version of os.machine.runtime.KillRequest that names
+// the machine by name rather than id.
+type KillRequest struct {
+	ApiHostname string `protobuf:"bytes,1,opt,name=api_hostname,json=apiHostname,proto3" json:"api_hostname,omitempty"`
+	ApiPort     uint32 `protobuf:"varint,2,opt,name=api_port,json=apiPort,proto3" json:"api_port,omitempty"`
+	Name        string `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	Signal      int32  `protobuf:"varint,5,opt,name=signal,proto3" json:"signal,omitempty"`
+}
+
+func (m *KillRequest) Reset()                { *m = KillRequest{} }
+func (m *KillRequest) String() string        { return proto.CompactTextString(m) }
+func (*KillRequest) ProtoMessage()           {}
+func (*KillRequest) XXX_MessageName() string { return "os.machine.runtime.KillRequest" }
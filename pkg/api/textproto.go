@@ -0,0 +1,209 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	api_api_v0 "alt-os/api/api/v0"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// Indentation of each nesting level of written textproto.
+const _TEXTPROTO_INDENT = "  "
+
+// readTextMessageList reads a message list in textproto format from the file.
+// Message definitions are written in the expanded form of google.protobuf.Any,
+// as a nested message named by the kind/version type url of the definition:
+//
+//	messages {
+//	  kind: "os.machine.runtime.StartRequest"
+//	  version: "v0"
+//	  def {
+//	    [os.machine.runtime.StartRequest/v0] {
+//	      id: "vm0"
+//	    }
+//	  }
+//	}
+//
+// The textproto parser cannot resolve these type urls itself, so each expanded
+// definition is replaced by its type url and serialized value before parsing.
+func readTextMessageList(filename string, msgList *api_api_v0.ApiMessageList) error {
+	data, err := readInputFile(filename)
+	if err != nil {
+		return err
+	}
+	text, err := collapseTextAnys(string(data))
+	if err != nil {
+		return errors.New(filename + ": " + err.Error())
+	}
+	if err := proto.UnmarshalText(text, msgList); err != nil {
+		return errors.New(filename + ": " + err.Error())
+	}
+	return nil
+}
+
+// writeTextMessageList writes the message list in textproto format to the
+// file, with message definitions in expanded form.
+func writeTextMessageList(filename string, msgList *api_api_v0.ApiMessageList) error {
+	var buf bytes.Buffer
+	for _, msg := range msgList.Messages {
+		header := &api_api_v0.ApiMessage{Kind: msg.Kind, Version: msg.Version, Id: msg.Id, DependsOn: msg.DependsOn}
+		buf.WriteString("messages {\n")
+		writeTextIndented(&buf, proto.MarshalTextString(header), 1)
+		if msg.Def != nil {
+			def, err := kindVersionResolver{}.Resolve(msg.Def.TypeUrl)
+			if err != nil {
+				return err
+			}
+			if err := proto.Unmarshal(msg.Def.Value, def); err != nil {
+				return err
+			}
+			writeTextIndented(&buf, "def {\n", 1)
+			writeTextIndented(&buf, "["+msg.Def.TypeUrl+"] {\n", 2)
+			writeTextIndented(&buf, proto.MarshalTextString(def), 3)
+			writeTextIndented(&buf, "}\n", 2)
+			writeTextIndented(&buf, "}\n", 1)
+		}
+		buf.WriteString("}\n")
+	}
+	return writeOutputFile(filename, buf.Bytes())
+}
+
+// writeTextIndented writes each line of the text indented by the level.
+func writeTextIndented(buf *bytes.Buffer, text string, level int) {
+	indent := strings.Repeat(_TEXTPROTO_INDENT, level)
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if strings.TrimSpace(line) != "" {
+			buf.WriteString(indent + line)
+		}
+	}
+}
+
+// collapseTextAnys replaces each expanded google.protobuf.Any of the textproto
+// with its type url and serialized value.
+func collapseTextAnys(text string) (string, error) {
+	var out strings.Builder
+	for i := 0; i < len(text); {
+		switch text[i] {
+		case '#':
+			end := textCommentEnd(text, i)
+			out.WriteString(text[i:end])
+			i = end
+		case '"', '\'':
+			end, err := textStringEnd(text, i)
+			if err != nil {
+				return "", err
+			}
+			out.WriteString(text[i:end])
+			i = end
+		case '[':
+			end := strings.IndexByte(text[i:], ']')
+			if end < 0 {
+				return "", fmt.Errorf("line %d: unterminated type url", textLine(text, i))
+			}
+			typeUrl := strings.TrimSpace(text[i+1 : i+end])
+			open := i + end + 1
+			for open < len(text) && strings.ContainsRune(" \t\r\n:", rune(text[open])) {
+				open++
+			}
+			if open == len(text) || (text[open] != '{' && text[open] != '<') {
+				return "", fmt.Errorf("line %d: expected message after type url %s", textLine(text, i), typeUrl)
+			}
+			closing, err := textMessageEnd(text, open)
+			if err != nil {
+				return "", err
+			}
+			value, err := marshalTextAny(typeUrl, text[open+1:closing])
+			if err != nil {
+				return "", fmt.Errorf("line %d: %s: %v", textLine(text, i), typeUrl, err)
+			}
+			fmt.Fprintf(&out, "type_url: %q value: \"%s\"", typeUrl, textOctalEscape(value))
+			i = closing + 1
+		default:
+			out.WriteByte(text[i])
+			i++
+		}
+	}
+	return out.String(), nil
+}
+
+// marshalTextAny parses the textproto of a message of the type url and returns
+// its serialized value.
+func marshalTextAny(typeUrl, text string) ([]byte, error) {
+	msg, err := kindVersionResolver{}.Resolve(typeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if text, err = collapseTextAnys(text); err != nil {
+		return nil, err
+	}
+	if err := proto.UnmarshalText(text, msg); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(msg)
+}
+
+// textMessageEnd returns the index of the delimiter that closes the message
+// opened at the index.
+func textMessageEnd(text string, open int) (int, error) {
+	depth := 0
+	for i := open; i < len(text); {
+		switch text[i] {
+		case '#':
+			i = textCommentEnd(text, i)
+			continue
+		case '"', '\'':
+			end, err := textStringEnd(text, i)
+			if err != nil {
+				return 0, err
+			}
+			i = end
+			continue
+		case '{', '<':
+			depth++
+		case '}', '>':
+			if depth--; depth == 0 {
+				return i, nil
+			}
+		}
+		i++
+	}
+	return 0, fmt.Errorf("line %d: unterminated message", textLine(text, open))
+}
+
+// textCommentEnd returns the index of the end of the comment at the index.
+func textCommentEnd(text string, start int) int {
+	if end := strings.IndexByte(text[start:], '\n'); end >= 0 {
+		return start + end
+	}
+	return len(text)
+}
+
+// textStringEnd returns the index after the end of the quoted string at the index.
+func textStringEnd(text string, start int) (int, error) {
+	for i := start + 1; i < len(text) && text[i] != '\n'; i++ {
+		if text[i] == '\\' {
+			i++
+		} else if text[i] == text[start] {
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("line %d: unterminated string", textLine(text, start))
+}
+
+// textLine returns the line number of the index of the text.
+func textLine(text string, i int) int {
+	return strings.Count(text[:i], "\n") + 1
+}
+
+// textOctalEscape escapes each byte of the data for a textproto string.
+func textOctalEscape(data []byte) string {
+	var out strings.Builder
+	for _, b := range data {
+		fmt.Fprintf(&out, "\\%03o", b)
+	}
+	return out.String()
+}
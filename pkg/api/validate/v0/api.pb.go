@@ -0,0 +1,95 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/api/validate/v0/api.proto
+
+package v0
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+var E_Required = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         51001,
+	Name:          "validate.required",
+	Tag:           "varint,51001,opt,name=required",
+	Filename:      "pkg/api/validate/v0/api.proto",
+}
+
+var E_Min = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*float64)(nil),
+	Field:         51002,
+	Name:          "validate.min",
+	Tag:           "fixed64,51002,opt,name=min",
+	Filename:      "pkg/api/validate/v0/api.proto",
+}
+
+var E_Max = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*float64)(nil),
+	Field:         51003,
+	Name:          "validate.max",
+	Tag:           "fixed64,51003,opt,name=max",
+	Filename:      "pkg/api/validate/v0/api.proto",
+}
+
+var E_Pattern = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         51004,
+	Name:          "validate.pattern",
+	Tag:           "bytes,51004,opt,name=pattern",
+	Filename:      "pkg/api/validate/v0/api.proto",
+}
+
+var E_Exclusive = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         51005,
+	Name:          "validate.exclusive",
+	Tag:           "bytes,51005,opt,name=exclusive",
+	Filename:      "pkg/api/validate/v0/api.proto",
+}
+
+func init() {
+	proto.RegisterExtension(E_Required)
+	proto.RegisterExtension(E_Min)
+	proto.RegisterExtension(E_Max)
+	proto.RegisterExtension(E_Pattern)
+	proto.RegisterExtension(E_Exclusive)
+}
+
+func init() { proto.RegisterFile("pkg/api/validate/v0/api.proto", fileDescriptor_d63c0514f29105d7) }
+
+var fileDescriptor_d63c0514f29105d7 = []byte{
+	// 210 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0xc8, 0x4e, 0xd7,
+	0x4f, 0x2c, 0xc8, 0xd4, 0x2f, 0x4b, 0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0x49, 0xd5, 0x2f, 0x33, 0x00,
+	0xf1, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x38, 0x60, 0xc2, 0x52, 0x0a, 0xe9, 0xf9, 0xf9,
+	0xe9, 0x39, 0xa9, 0xfa, 0x60, 0xf1, 0xa4, 0xd2, 0x34, 0xfd, 0x94, 0xd4, 0xe2, 0xe4, 0xa2, 0xcc,
+	0x82, 0x92, 0xfc, 0x22, 0x88, 0x5a, 0x2b, 0x6b, 0x2e, 0x8e, 0xa2, 0xd4, 0xc2, 0xd2, 0xcc, 0xa2,
+	0xd4, 0x14, 0x21, 0x59, 0x3d, 0x88, 0x72, 0x3d, 0x98, 0x72, 0x3d, 0xb7, 0xcc, 0xd4, 0x9c, 0x14,
+	0xff, 0x82, 0x92, 0xcc, 0xfc, 0xbc, 0x62, 0x89, 0x9d, 0x7d, 0xcc, 0x0a, 0x8c, 0x1a, 0x1c, 0x41,
+	0x70, 0x0d, 0x56, 0x86, 0x5c, 0xcc, 0xb9, 0x99, 0x79, 0x84, 0xf4, 0xed, 0x02, 0xeb, 0x63, 0x0c,
+	0x02, 0xa9, 0x05, 0x6b, 0x49, 0xac, 0x20, 0xa4, 0x65, 0x37, 0x5c, 0x4b, 0x62, 0x85, 0x95, 0x25,
+	0x17, 0x7b, 0x41, 0x62, 0x49, 0x49, 0x6a, 0x11, 0x41, 0x9b, 0xf6, 0x80, 0xb5, 0x71, 0x06, 0xc1,
+	0xd4, 0x5b, 0xd9, 0x72, 0x71, 0xa6, 0x56, 0x24, 0xe7, 0x94, 0x16, 0x67, 0x96, 0xa5, 0x12, 0xd2,
+	0xbc, 0x17, 0xaa, 0x19, 0xa1, 0xc3, 0x49, 0x22, 0x4a, 0x2c, 0x31, 0xa7, 0x44, 0x37, 0xbf, 0x18,
+	0x3d, 0xb0, 0x93, 0xd8, 0xc0, 0x66, 0x18, 0x03, 0x06, 0x00, 0xc4, 0xc7, 0xeb, 0x31, 0x8a, 0x01,
+	0x00, 0x00,
+}
@@ -0,0 +1,8 @@
+// Copyright © 2022. All rights reserved.
+
+//
+// Package containing the protobuf field options declaring validation rules of
+// messages, and the violations reported by the Validate methods generated from
+// them.
+//
+package v0
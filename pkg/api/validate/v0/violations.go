@@ -0,0 +1,73 @@
+package v0
+
+import (
+	"errors"
+	"strings"
+)
+
+// Violation is a violation of a validation rule by the value of a field.
+type Violation struct {
+	Field  string // Path of the field by json names, e.g. storage[0].size.
+	Reason string // Description of the rule violated.
+}
+
+// Violations is the error of a message that violates validation rules, which
+// lists every violation.
+type Violations []*Violation
+
+func (v Violations) Error() string {
+	var msgs []string
+	for _, violation := range v {
+		msgs = append(msgs, violation.Field+": "+violation.Reason)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Err returns the violations as an error, or nil if there are none.
+func (v Violations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
+
+// Add adds a violation of the rule by the field.
+func (v *Violations) Add(field, reason string) {
+	*v = append(*v, &Violation{Field: field, Reason: reason})
+}
+
+// AddNested adds the violations of the error of validating the message of
+// the field, with their fields prefixed by the field if not empty. Other
+// errors are added as a violation of the field.
+func (v *Violations) AddNested(field string, err error) {
+	var nested Violations
+	if err == nil {
+		return
+	} else if !errors.As(err, &nested) {
+		v.Add(field, err.Error())
+		return
+	}
+	for _, violation := range nested {
+		if field == "" {
+			v.Add(violation.Field, violation.Reason)
+		} else {
+			v.Add(field+"."+violation.Field, violation.Reason)
+		}
+	}
+}
+
+// AddExclusive adds a violation if more than one of the fields of an
+// exclusive group is set, or if none is and the group is required.
+func (v *Violations) AddExclusive(fields []string, set []bool, required bool) {
+	var setFields []string
+	for i, field := range fields {
+		if set[i] {
+			setFields = append(setFields, field)
+		}
+	}
+	if len(setFields) > 1 {
+		v.Add(setFields[0], "not allowed with "+strings.Join(setFields[1:], ", "))
+	} else if len(setFields) == 0 && required {
+		v.Add(fields[0], "required unless "+strings.Join(fields[1:], " or ")+" is set")
+	}
+}
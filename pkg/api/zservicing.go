@@ -6,640 +6,825 @@ import (
 	api_os_container_runtime_v0 "alt-os/api/os/container/runtime/v0"
 	api_os_machine_image_v0 "alt-os/api/os/machine/image/v0"
 	api_os_machine_runtime_v0 "alt-os/api/os/machine/runtime/v0"
+	"context"
 	"errors"
-	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
 )
 
-// serviceMessageKinds processes each specific message kind.
-func serviceMessageKinds(ctxt *ApiServiceContext) error {
-	for _, apiMsg := range ctxt.MessageQueue {
-		switch msg := apiMsg.Def.(type) {
-		default:
-			return errors.New("invalid message kind: " + apiMsg.Kind)
-		case *api_os_container_bundle_v0.ApiServeRequest:
-			if err := newServer("os.container.bundle.ContainerBundleService", "v0", msg, ctxt); err != nil {
-				return err
-			} else if err := req_api_os_container_bundle_v0_ContainerBundleService_v0_ApiServe(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_bundle_v0.ApiUnserveRequest:
-			if err := req_api_os_container_bundle_v0_ContainerBundleService_v0_ApiUnserve(msg, ctxt); err != nil {
-				return err
-			} else if err := stopServer(fmt.Sprintf("%s:%d", msg.GetApiHostname(), msg.GetApiPort()), ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_bundle_v0.CreateRequest:
-			if err := req_api_os_container_bundle_v0_ContainerBundleService_v0_Create(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_runtime_v0.ApiServeRequest:
-			if err := newServer("os.container.runtime.ContainerRuntimeService", "v0", msg, ctxt); err != nil {
-				return err
-			} else if err := req_api_os_container_runtime_v0_ContainerRuntimeService_v0_ApiServe(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_runtime_v0.ApiUnserveRequest:
-			if err := req_api_os_container_runtime_v0_ContainerRuntimeService_v0_ApiUnserve(msg, ctxt); err != nil {
-				return err
-			} else if err := stopServer(fmt.Sprintf("%s:%d", msg.GetApiHostname(), msg.GetApiPort()), ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_runtime_v0.ListRequest:
-			if err := req_api_os_container_runtime_v0_ContainerRuntimeService_v0_List(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_runtime_v0.QueryStateRequest:
-			if err := req_api_os_container_runtime_v0_ContainerRuntimeService_v0_QueryState(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_runtime_v0.CreateRequest:
-			if err := req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Create(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_runtime_v0.StartRequest:
-			if err := req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Start(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_runtime_v0.KillRequest:
-			if err := req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Kill(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_container_runtime_v0.DeleteRequest:
-			if err := req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Delete(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_image_v0.ApiServeRequest:
-			if err := newServer("os.machine.image.VmImageService", "v0", msg, ctxt); err != nil {
-				return err
-			} else if err := req_api_os_machine_image_v0_VmImageService_v0_ApiServe(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_image_v0.ApiUnserveRequest:
-			if err := req_api_os_machine_image_v0_VmImageService_v0_ApiUnserve(msg, ctxt); err != nil {
-				return err
-			} else if err := stopServer(fmt.Sprintf("%s:%d", msg.GetApiHostname(), msg.GetApiPort()), ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_image_v0.CreateRequest:
-			if err := req_api_os_machine_image_v0_VmImageService_v0_Create(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_runtime_v0.ApiServeRequest:
-			if err := newServer("os.machine.runtime.VmRuntimeService", "v0", msg, ctxt); err != nil {
-				return err
-			} else if err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_ApiServe(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_runtime_v0.ApiUnserveRequest:
-			if err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_ApiUnserve(msg, ctxt); err != nil {
-				return err
-			} else if err := stopServer(fmt.Sprintf("%s:%d", msg.GetApiHostname(), msg.GetApiPort()), ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_runtime_v0.ListRequest:
-			if err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_List(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_runtime_v0.QueryStateRequest:
-			if err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_QueryState(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_runtime_v0.CreateRequest:
-			if err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_Create(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_runtime_v0.StartRequest:
-			if err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_Start(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_runtime_v0.KillRequest:
-			if err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_Kill(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_runtime_v0.DeleteRequest:
-			if err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_Delete(msg, ctxt); err != nil {
-				return err
-			}
-		case *api_os_machine_runtime_v0.DeployRequest:
-			if err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_Deploy(msg, ctxt); err != nil {
-				return err
-			}
-		}
-	}
-	for addr := range ctxt.AddrServerMap {
-		if err := ctxt.AddrStopWaitMap[addr](); err != nil {
-			return nil
-		}
+// serviceMessageKind processes a single message of a specific kind and returns
+// the response to it.
+func serviceMessageKind(apiMsg *ApiProtoMessage, ctxt *ApiServiceContext) (proto.Message, error) {
+	switch msg := apiMsg.Def.(type) {
+	default:
+		return nil, errors.New("invalid message kind: " + apiMsg.Kind)
+	case *api_os_container_bundle_v0.ApiServeRequest:
+		if err := newServer("os.container.bundle.ContainerBundleService", msg, ctxt); err != nil {
+			return nil, err
+		}
+		return req_api_os_container_bundle_v0_ContainerBundleService_v0_ApiServe(msg, ctxt)
+	case *api_os_container_bundle_v0.ApiUnserveRequest:
+		if resp, err := req_api_os_container_bundle_v0_ContainerBundleService_v0_ApiUnserve(msg, ctxt); err != nil {
+			return nil, err
+		} else if err := stopServer(MessageAddr(msg), "os.container.bundle.ContainerBundleService", ctxt); err != nil {
+			return nil, err
+		} else {
+			return resp, nil
+		}
+	case *api_os_container_bundle_v0.CreateRequest:
+		return req_api_os_container_bundle_v0_ContainerBundleService_v0_Create(msg, ctxt)
+	case *api_os_container_runtime_v0.ApiServeRequest:
+		if err := newServer("os.container.runtime.ContainerRuntimeService", msg, ctxt); err != nil {
+			return nil, err
+		}
+		return req_api_os_container_runtime_v0_ContainerRuntimeService_v0_ApiServe(msg, ctxt)
+	case *api_os_container_runtime_v0.ApiUnserveRequest:
+		if resp, err := req_api_os_container_runtime_v0_ContainerRuntimeService_v0_ApiUnserve(msg, ctxt); err != nil {
+			return nil, err
+		} else if err := stopServer(MessageAddr(msg), "os.container.runtime.ContainerRuntimeService", ctxt); err != nil {
+			return nil, err
+		} else {
+			return resp, nil
+		}
+	case *api_os_container_runtime_v0.ListRequest:
+		return req_api_os_container_runtime_v0_ContainerRuntimeService_v0_List(msg, ctxt)
+	case *api_os_container_runtime_v0.QueryStateRequest:
+		return req_api_os_container_runtime_v0_ContainerRuntimeService_v0_QueryState(msg, ctxt)
+	case *api_os_container_runtime_v0.CreateRequest:
+		return req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Create(msg, ctxt)
+	case *api_os_container_runtime_v0.StartRequest:
+		return req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Start(msg, ctxt)
+	case *api_os_container_runtime_v0.KillRequest:
+		return req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Kill(msg, ctxt)
+	case *api_os_container_runtime_v0.DeleteRequest:
+		return req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Delete(msg, ctxt)
+	case *api_os_machine_image_v0.ApiServeRequest:
+		if err := newServer("os.machine.image.VmImageService", msg, ctxt); err != nil {
+			return nil, err
+		}
+		return req_api_os_machine_image_v0_VmImageService_v0_ApiServe(msg, ctxt)
+	case *api_os_machine_image_v0.ApiUnserveRequest:
+		if resp, err := req_api_os_machine_image_v0_VmImageService_v0_ApiUnserve(msg, ctxt); err != nil {
+			return nil, err
+		} else if err := stopServer(MessageAddr(msg), "os.machine.image.VmImageService", ctxt); err != nil {
+			return nil, err
+		} else {
+			return resp, nil
+		}
+	case *api_os_machine_image_v0.CreateRequest:
+		return req_api_os_machine_image_v0_VmImageService_v0_Create(msg, ctxt)
+	case *api_os_machine_runtime_v0.ApiServeRequest:
+		if err := newServer("os.machine.runtime.VmRuntimeService", msg, ctxt); err != nil {
+			return nil, err
+		}
+		return req_api_os_machine_runtime_v0_VmRuntimeService_v0_ApiServe(msg, ctxt)
+	case *api_os_machine_runtime_v0.ApiUnserveRequest:
+		if resp, err := req_api_os_machine_runtime_v0_VmRuntimeService_v0_ApiUnserve(msg, ctxt); err != nil {
+			return nil, err
+		} else if err := stopServer(MessageAddr(msg), "os.machine.runtime.VmRuntimeService", ctxt); err != nil {
+			return nil, err
+		} else {
+			return resp, nil
+		}
+	case *api_os_machine_runtime_v0.ListRequest:
+		return req_api_os_machine_runtime_v0_VmRuntimeService_v0_List(msg, ctxt)
+	case *api_os_machine_runtime_v0.QueryStateRequest:
+		return req_api_os_machine_runtime_v0_VmRuntimeService_v0_QueryState(msg, ctxt)
+	case *api_os_machine_runtime_v0.CreateRequest:
+		return req_api_os_machine_runtime_v0_VmRuntimeService_v0_Create(msg, ctxt)
+	case *api_os_machine_runtime_v0.StartRequest:
+		return req_api_os_machine_runtime_v0_VmRuntimeService_v0_Start(msg, ctxt)
+	case *api_os_machine_runtime_v0.KillRequest:
+		return req_api_os_machine_runtime_v0_VmRuntimeService_v0_Kill(msg, ctxt)
+	case *api_os_machine_runtime_v0.DeleteRequest:
+		return req_api_os_machine_runtime_v0_VmRuntimeService_v0_Delete(msg, ctxt)
+	case *api_os_machine_runtime_v0.DeployRequest:
+		return req_api_os_machine_runtime_v0_VmRuntimeService_v0_Deploy(msg, ctxt)
 	}
-	ctxt.ServerWg.Wait()
-	return nil
 }
 
-// makeClientKind makes a new specific grpc client kind in the context.
-func makeClientKind(addr string, ctxt *ApiServiceContext) error {
-	switch ctxt.AddrKindVerMap[addr] {
+// makeClientKind makes a new specific grpc client kind for the connection.
+func makeClientKind(kindVer string, conn *grpc.ClientConn) (interface{}, error) {
+	switch kindVer {
 	default:
-		return errors.New("invalid client kindVer: " + ctxt.AddrKindVerMap[addr])
+		return nil, errors.New("invalid client kindVer: " + kindVer)
 
 	case "os.container.bundle.ContainerBundleService/v0":
-		ctxt.AddrClientMap[addr] = api_os_container_bundle_v0.NewContainerBundleServiceClient(ctxt.AddrGrpcConnMap[addr])
+		return api_os_container_bundle_v0.NewContainerBundleServiceClient(conn), nil
 	case "os.container.runtime.ContainerRuntimeService/v0":
-		ctxt.AddrClientMap[addr] = api_os_container_runtime_v0.NewContainerRuntimeServiceClient(ctxt.AddrGrpcConnMap[addr])
+		return api_os_container_runtime_v0.NewContainerRuntimeServiceClient(conn), nil
 	case "os.machine.image.VmImageService/v0":
-		ctxt.AddrClientMap[addr] = api_os_machine_image_v0.NewVmImageServiceClient(ctxt.AddrGrpcConnMap[addr])
+		return api_os_machine_image_v0.NewVmImageServiceClient(conn), nil
 	case "os.machine.runtime.VmRuntimeService/v0":
-		ctxt.AddrClientMap[addr] = api_os_machine_runtime_v0.NewVmRuntimeServiceClient(ctxt.AddrGrpcConnMap[addr])
+		return api_os_machine_runtime_v0.NewVmRuntimeServiceClient(conn), nil
 	}
-	if ctxt.AddrClientMap[addr] == nil {
-		return errors.New("failed to make client " + ctxt.AddrKindVerMap[addr] + " for " + addr)
-	}
-	return nil
 }
 
-// makeServerKind makes a new specific grpc server kind in the context.
-func makeServerKind(addr string, ctxt *ApiServiceContext) error {
-	switch ctxt.AddrKindVerMap[addr] {
+// makeServerKind registers the implementation of a specific grpc server kind
+// on the server and returns it.
+func makeServerKind(kindVer string, grpcServer *grpc.Server, impl interface{}) (interface{}, error) {
+	switch kindVer {
 	default:
-		return errors.New("invalid impl kindVer: " + ctxt.AddrKindVerMap[addr])
+		return nil, errors.New("invalid impl kindVer: " + kindVer)
 
 	case "os.container.bundle.ContainerBundleService/v0":
-		srv := ctxt.KindImplMap[ctxt.AddrKindVerMap[addr]].(api_os_container_bundle_v0.ContainerBundleServiceServer)
-		api_os_container_bundle_v0.RegisterContainerBundleServiceServer(ctxt.AddrGrpcServerMap[addr], srv)
-		ctxt.AddrServerMap[addr] = srv
+		srv, ok := impl.(api_os_container_bundle_v0.ContainerBundleServiceServer)
+		if !ok {
+			return nil, errors.New("invalid impl for kindVer: " + kindVer)
+		}
+		grpcServer.RegisterService(&grpc.ServiceDesc{
+			ServiceName: "os.container.bundle.ContainerBundleService",
+			HandlerType: (*api_os_container_bundle_v0.ContainerBundleServiceServer)(nil),
+			Methods: []grpc.MethodDesc{
+				{
+					MethodName: "ApiServe",
+					Handler: convertingMethodHandler("/os.container.bundle.ContainerBundleService/ApiServe", "os.container.bundle.ApiServeRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_bundle_v0.ContainerBundleServiceServer).ApiServe(ctx, req.(*api_os_container_bundle_v0.ApiServeRequest))
+						}),
+				},
+				{
+					MethodName: "ApiUnserve",
+					Handler: convertingMethodHandler("/os.container.bundle.ContainerBundleService/ApiUnserve", "os.container.bundle.ApiUnserveRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_bundle_v0.ContainerBundleServiceServer).ApiUnserve(ctx, req.(*api_os_container_bundle_v0.ApiUnserveRequest))
+						}),
+				},
+				{
+					MethodName: "Create",
+					Handler: convertingMethodHandler("/os.container.bundle.ContainerBundleService/Create", "os.container.bundle.CreateRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_bundle_v0.ContainerBundleServiceServer).Create(ctx, req.(*api_os_container_bundle_v0.CreateRequest))
+						}),
+				},
+			},
+			Metadata: "pkg/api/os/container/bundle/v0/api.proto",
+		}, srv)
+		return srv, nil
 	case "os.container.runtime.ContainerRuntimeService/v0":
-		srv := ctxt.KindImplMap[ctxt.AddrKindVerMap[addr]].(api_os_container_runtime_v0.ContainerRuntimeServiceServer)
-		api_os_container_runtime_v0.RegisterContainerRuntimeServiceServer(ctxt.AddrGrpcServerMap[addr], srv)
-		ctxt.AddrServerMap[addr] = srv
+		srv, ok := impl.(api_os_container_runtime_v0.ContainerRuntimeServiceServer)
+		if !ok {
+			return nil, errors.New("invalid impl for kindVer: " + kindVer)
+		}
+		grpcServer.RegisterService(&grpc.ServiceDesc{
+			ServiceName: "os.container.runtime.ContainerRuntimeService",
+			HandlerType: (*api_os_container_runtime_v0.ContainerRuntimeServiceServer)(nil),
+			Methods: []grpc.MethodDesc{
+				{
+					MethodName: "ApiServe",
+					Handler: convertingMethodHandler("/os.container.runtime.ContainerRuntimeService/ApiServe", "os.container.runtime.ApiServeRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_runtime_v0.ContainerRuntimeServiceServer).ApiServe(ctx, req.(*api_os_container_runtime_v0.ApiServeRequest))
+						}),
+				},
+				{
+					MethodName: "ApiUnserve",
+					Handler: convertingMethodHandler("/os.container.runtime.ContainerRuntimeService/ApiUnserve", "os.container.runtime.ApiUnserveRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_runtime_v0.ContainerRuntimeServiceServer).ApiUnserve(ctx, req.(*api_os_container_runtime_v0.ApiUnserveRequest))
+						}),
+				},
+				{
+					MethodName: "List",
+					Handler: convertingMethodHandler("/os.container.runtime.ContainerRuntimeService/List", "os.container.runtime.ListRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_runtime_v0.ContainerRuntimeServiceServer).List(ctx, req.(*api_os_container_runtime_v0.ListRequest))
+						}),
+				},
+				{
+					MethodName: "QueryState",
+					Handler: convertingMethodHandler("/os.container.runtime.ContainerRuntimeService/QueryState", "os.container.runtime.QueryStateRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_runtime_v0.ContainerRuntimeServiceServer).QueryState(ctx, req.(*api_os_container_runtime_v0.QueryStateRequest))
+						}),
+				},
+				{
+					MethodName: "Create",
+					Handler: convertingMethodHandler("/os.container.runtime.ContainerRuntimeService/Create", "os.container.runtime.CreateRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_runtime_v0.ContainerRuntimeServiceServer).Create(ctx, req.(*api_os_container_runtime_v0.CreateRequest))
+						}),
+				},
+				{
+					MethodName: "Start",
+					Handler: convertingMethodHandler("/os.container.runtime.ContainerRuntimeService/Start", "os.container.runtime.StartRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_runtime_v0.ContainerRuntimeServiceServer).Start(ctx, req.(*api_os_container_runtime_v0.StartRequest))
+						}),
+				},
+				{
+					MethodName: "Kill",
+					Handler: convertingMethodHandler("/os.container.runtime.ContainerRuntimeService/Kill", "os.container.runtime.KillRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_runtime_v0.ContainerRuntimeServiceServer).Kill(ctx, req.(*api_os_container_runtime_v0.KillRequest))
+						}),
+				},
+				{
+					MethodName: "Delete",
+					Handler: convertingMethodHandler("/os.container.runtime.ContainerRuntimeService/Delete", "os.container.runtime.DeleteRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_container_runtime_v0.ContainerRuntimeServiceServer).Delete(ctx, req.(*api_os_container_runtime_v0.DeleteRequest))
+						}),
+				},
+			},
+			Metadata: "pkg/api/os/container/runtime/v0/api.proto",
+		}, srv)
+		return srv, nil
 	case "os.machine.image.VmImageService/v0":
-		srv := ctxt.KindImplMap[ctxt.AddrKindVerMap[addr]].(api_os_machine_image_v0.VmImageServiceServer)
-		api_os_machine_image_v0.RegisterVmImageServiceServer(ctxt.AddrGrpcServerMap[addr], srv)
-		ctxt.AddrServerMap[addr] = srv
+		srv, ok := impl.(api_os_machine_image_v0.VmImageServiceServer)
+		if !ok {
+			return nil, errors.New("invalid impl for kindVer: " + kindVer)
+		}
+		grpcServer.RegisterService(&grpc.ServiceDesc{
+			ServiceName: "os.machine.image.VmImageService",
+			HandlerType: (*api_os_machine_image_v0.VmImageServiceServer)(nil),
+			Methods: []grpc.MethodDesc{
+				{
+					MethodName: "ApiServe",
+					Handler: convertingMethodHandler("/os.machine.image.VmImageService/ApiServe", "os.machine.image.ApiServeRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_image_v0.VmImageServiceServer).ApiServe(ctx, req.(*api_os_machine_image_v0.ApiServeRequest))
+						}),
+				},
+				{
+					MethodName: "ApiUnserve",
+					Handler: convertingMethodHandler("/os.machine.image.VmImageService/ApiUnserve", "os.machine.image.ApiUnserveRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_image_v0.VmImageServiceServer).ApiUnserve(ctx, req.(*api_os_machine_image_v0.ApiUnserveRequest))
+						}),
+				},
+				{
+					MethodName: "Create",
+					Handler: convertingMethodHandler("/os.machine.image.VmImageService/Create", "os.machine.image.CreateRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_image_v0.VmImageServiceServer).Create(ctx, req.(*api_os_machine_image_v0.CreateRequest))
+						}),
+				},
+			},
+			Metadata: "pkg/api/os/machine/image/v0/api.proto",
+		}, srv)
+		return srv, nil
 	case "os.machine.runtime.VmRuntimeService/v0":
-		srv := ctxt.KindImplMap[ctxt.AddrKindVerMap[addr]].(api_os_machine_runtime_v0.VmRuntimeServiceServer)
-		api_os_machine_runtime_v0.RegisterVmRuntimeServiceServer(ctxt.AddrGrpcServerMap[addr], srv)
-		ctxt.AddrServerMap[addr] = srv
+		srv, ok := impl.(api_os_machine_runtime_v0.VmRuntimeServiceServer)
+		if !ok {
+			return nil, errors.New("invalid impl for kindVer: " + kindVer)
+		}
+		grpcServer.RegisterService(&grpc.ServiceDesc{
+			ServiceName: "os.machine.runtime.VmRuntimeService",
+			HandlerType: (*api_os_machine_runtime_v0.VmRuntimeServiceServer)(nil),
+			Methods: []grpc.MethodDesc{
+				{
+					MethodName: "ApiServe",
+					Handler: convertingMethodHandler("/os.machine.runtime.VmRuntimeService/ApiServe", "os.machine.runtime.ApiServeRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_runtime_v0.VmRuntimeServiceServer).ApiServe(ctx, req.(*api_os_machine_runtime_v0.ApiServeRequest))
+						}),
+				},
+				{
+					MethodName: "ApiUnserve",
+					Handler: convertingMethodHandler("/os.machine.runtime.VmRuntimeService/ApiUnserve", "os.machine.runtime.ApiUnserveRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_runtime_v0.VmRuntimeServiceServer).ApiUnserve(ctx, req.(*api_os_machine_runtime_v0.ApiUnserveRequest))
+						}),
+				},
+				{
+					MethodName: "List",
+					Handler: convertingMethodHandler("/os.machine.runtime.VmRuntimeService/List", "os.machine.runtime.ListRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_runtime_v0.VmRuntimeServiceServer).List(ctx, req.(*api_os_machine_runtime_v0.ListRequest))
+						}),
+				},
+				{
+					MethodName: "QueryState",
+					Handler: convertingMethodHandler("/os.machine.runtime.VmRuntimeService/QueryState", "os.machine.runtime.QueryStateRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_runtime_v0.VmRuntimeServiceServer).QueryState(ctx, req.(*api_os_machine_runtime_v0.QueryStateRequest))
+						}),
+				},
+				{
+					MethodName: "Create",
+					Handler: convertingMethodHandler("/os.machine.runtime.VmRuntimeService/Create", "os.machine.runtime.CreateRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_runtime_v0.VmRuntimeServiceServer).Create(ctx, req.(*api_os_machine_runtime_v0.CreateRequest))
+						}),
+				},
+				{
+					MethodName: "Start",
+					Handler: convertingMethodHandler("/os.machine.runtime.VmRuntimeService/Start", "os.machine.runtime.StartRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_runtime_v0.VmRuntimeServiceServer).Start(ctx, req.(*api_os_machine_runtime_v0.StartRequest))
+						}),
+				},
+				{
+					MethodName: "Kill",
+					Handler: convertingMethodHandler("/os.machine.runtime.VmRuntimeService/Kill", "os.machine.runtime.KillRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_runtime_v0.VmRuntimeServiceServer).Kill(ctx, req.(*api_os_machine_runtime_v0.KillRequest))
+						}),
+				},
+				{
+					MethodName: "Delete",
+					Handler: convertingMethodHandler("/os.machine.runtime.VmRuntimeService/Delete", "os.machine.runtime.DeleteRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_runtime_v0.VmRuntimeServiceServer).Delete(ctx, req.(*api_os_machine_runtime_v0.DeleteRequest))
+						}),
+				},
+				{
+					MethodName: "Deploy",
+					Handler: convertingMethodHandler("/os.machine.runtime.VmRuntimeService/Deploy", "os.machine.runtime.DeployRequest", "v0",
+						func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+							return srv.(api_os_machine_runtime_v0.VmRuntimeServiceServer).Deploy(ctx, req.(*api_os_machine_runtime_v0.DeployRequest))
+						}),
+				},
+			},
+			Metadata: "pkg/api/os/machine/runtime/v0/api.proto",
+		}, srv)
+		return srv, nil
 	}
-	return nil
 }
 
-// Specific kinds follow grpc request calls follow. Functions called by serviceMessageKinds.
+// Specific kinds follow grpc request calls follow. Functions called by serviceMessageKind.
 
-func req_api_os_container_bundle_v0_ContainerBundleService_v0_ApiServe(req *api_os_container_bundle_v0.ApiServeRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.bundle.ContainerBundleService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_bundle_v0_ContainerBundleService_v0_ApiServe(req *api_os_container_bundle_v0.ApiServeRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.bundle.ContainerBundleService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_bundle_v0.ContainerBundleServiceClient)
+		client, ok := kindClient.(api_os_container_bundle_v0.ContainerBundleServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.ApiServe(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.bundle.ContainerBundleService/v0.ApiServe"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.bundle.ContainerBundleService/v0.ApiServe"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_bundle_v0_ContainerBundleService_v0_ApiUnserve(req *api_os_container_bundle_v0.ApiUnserveRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.bundle.ContainerBundleService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_bundle_v0_ContainerBundleService_v0_ApiUnserve(req *api_os_container_bundle_v0.ApiUnserveRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.bundle.ContainerBundleService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_bundle_v0.ContainerBundleServiceClient)
+		client, ok := kindClient.(api_os_container_bundle_v0.ContainerBundleServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.ApiUnserve(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.bundle.ContainerBundleService/v0.ApiUnserve"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.bundle.ContainerBundleService/v0.ApiUnserve"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_bundle_v0_ContainerBundleService_v0_Create(req *api_os_container_bundle_v0.CreateRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.bundle.ContainerBundleService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_bundle_v0_ContainerBundleService_v0_Create(req *api_os_container_bundle_v0.CreateRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.bundle.ContainerBundleService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_bundle_v0.ContainerBundleServiceClient)
+		client, ok := kindClient.(api_os_container_bundle_v0.ContainerBundleServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Create(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.bundle.ContainerBundleService/v0.Create"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.bundle.ContainerBundleService/v0.Create"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_ApiServe(req *api_os_container_runtime_v0.ApiServeRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_ApiServe(req *api_os_container_runtime_v0.ApiServeRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
+		client, ok := kindClient.(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.ApiServe(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.runtime.ContainerRuntimeService/v0.ApiServe"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.runtime.ContainerRuntimeService/v0.ApiServe"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_ApiUnserve(req *api_os_container_runtime_v0.ApiUnserveRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_ApiUnserve(req *api_os_container_runtime_v0.ApiUnserveRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
+		client, ok := kindClient.(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.ApiUnserve(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.runtime.ContainerRuntimeService/v0.ApiUnserve"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.runtime.ContainerRuntimeService/v0.ApiUnserve"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_List(req *api_os_container_runtime_v0.ListRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_List(req *api_os_container_runtime_v0.ListRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
+		client, ok := kindClient.(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.List(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.runtime.ContainerRuntimeService/v0.List"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.runtime.ContainerRuntimeService/v0.List"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_QueryState(req *api_os_container_runtime_v0.QueryStateRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_QueryState(req *api_os_container_runtime_v0.QueryStateRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
+		client, ok := kindClient.(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.QueryState(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.runtime.ContainerRuntimeService/v0.QueryState"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.runtime.ContainerRuntimeService/v0.QueryState"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Create(req *api_os_container_runtime_v0.CreateRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Create(req *api_os_container_runtime_v0.CreateRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
+		client, ok := kindClient.(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Create(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.runtime.ContainerRuntimeService/v0.Create"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.runtime.ContainerRuntimeService/v0.Create"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Start(req *api_os_container_runtime_v0.StartRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Start(req *api_os_container_runtime_v0.StartRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
+		client, ok := kindClient.(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Start(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.runtime.ContainerRuntimeService/v0.Start"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.runtime.ContainerRuntimeService/v0.Start"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Kill(req *api_os_container_runtime_v0.KillRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Kill(req *api_os_container_runtime_v0.KillRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
+		client, ok := kindClient.(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Kill(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.runtime.ContainerRuntimeService/v0.Kill"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.runtime.ContainerRuntimeService/v0.Kill"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Delete(req *api_os_container_runtime_v0.DeleteRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_container_runtime_v0_ContainerRuntimeService_v0_Delete(req *api_os_container_runtime_v0.DeleteRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.container.runtime.ContainerRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
+		client, ok := kindClient.(api_os_container_runtime_v0.ContainerRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Delete(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.container.runtime.ContainerRuntimeService/v0.Delete"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.container.runtime.ContainerRuntimeService/v0.Delete"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_image_v0_VmImageService_v0_ApiServe(req *api_os_machine_image_v0.ApiServeRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.image.VmImageService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_image_v0_VmImageService_v0_ApiServe(req *api_os_machine_image_v0.ApiServeRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.image.VmImageService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_image_v0.VmImageServiceClient)
+		client, ok := kindClient.(api_os_machine_image_v0.VmImageServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.ApiServe(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.image.VmImageService/v0.ApiServe"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.image.VmImageService/v0.ApiServe"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_image_v0_VmImageService_v0_ApiUnserve(req *api_os_machine_image_v0.ApiUnserveRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.image.VmImageService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_image_v0_VmImageService_v0_ApiUnserve(req *api_os_machine_image_v0.ApiUnserveRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.image.VmImageService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_image_v0.VmImageServiceClient)
+		client, ok := kindClient.(api_os_machine_image_v0.VmImageServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.ApiUnserve(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.image.VmImageService/v0.ApiUnserve"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.image.VmImageService/v0.ApiUnserve"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_image_v0_VmImageService_v0_Create(req *api_os_machine_image_v0.CreateRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.image.VmImageService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_image_v0_VmImageService_v0_Create(req *api_os_machine_image_v0.CreateRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.image.VmImageService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_image_v0.VmImageServiceClient)
+		client, ok := kindClient.(api_os_machine_image_v0.VmImageServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Create(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.image.VmImageService/v0.Create"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.image.VmImageService/v0.Create"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_runtime_v0_VmRuntimeService_v0_ApiServe(req *api_os_machine_runtime_v0.ApiServeRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_runtime_v0_VmRuntimeService_v0_ApiServe(req *api_os_machine_runtime_v0.ApiServeRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_runtime_v0.VmRuntimeServiceClient)
+		client, ok := kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.ApiServe(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.runtime.VmRuntimeService/v0.ApiServe"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.runtime.VmRuntimeService/v0.ApiServe"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_runtime_v0_VmRuntimeService_v0_ApiUnserve(req *api_os_machine_runtime_v0.ApiUnserveRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_runtime_v0_VmRuntimeService_v0_ApiUnserve(req *api_os_machine_runtime_v0.ApiUnserveRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_runtime_v0.VmRuntimeServiceClient)
+		client, ok := kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.ApiUnserve(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.runtime.VmRuntimeService/v0.ApiUnserve"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.runtime.VmRuntimeService/v0.ApiUnserve"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_runtime_v0_VmRuntimeService_v0_List(req *api_os_machine_runtime_v0.ListRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_runtime_v0_VmRuntimeService_v0_List(req *api_os_machine_runtime_v0.ListRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_runtime_v0.VmRuntimeServiceClient)
+		client, ok := kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.List(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.runtime.VmRuntimeService/v0.List"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.runtime.VmRuntimeService/v0.List"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_runtime_v0_VmRuntimeService_v0_QueryState(req *api_os_machine_runtime_v0.QueryStateRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_runtime_v0_VmRuntimeService_v0_QueryState(req *api_os_machine_runtime_v0.QueryStateRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_runtime_v0.VmRuntimeServiceClient)
+		client, ok := kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.QueryState(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.runtime.VmRuntimeService/v0.QueryState"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.runtime.VmRuntimeService/v0.QueryState"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Create(req *api_os_machine_runtime_v0.CreateRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Create(req *api_os_machine_runtime_v0.CreateRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_runtime_v0.VmRuntimeServiceClient)
+		client, ok := kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Create(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.runtime.VmRuntimeService/v0.Create"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.runtime.VmRuntimeService/v0.Create"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Start(req *api_os_machine_runtime_v0.StartRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Start(req *api_os_machine_runtime_v0.StartRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_runtime_v0.VmRuntimeServiceClient)
+		client, ok := kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Start(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.runtime.VmRuntimeService/v0.Start"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.runtime.VmRuntimeService/v0.Start"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Kill(req *api_os_machine_runtime_v0.KillRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Kill(req *api_os_machine_runtime_v0.KillRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_runtime_v0.VmRuntimeServiceClient)
+		client, ok := kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Kill(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.runtime.VmRuntimeService/v0.Kill"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.runtime.VmRuntimeService/v0.Kill"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Delete(req *api_os_machine_runtime_v0.DeleteRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Delete(req *api_os_machine_runtime_v0.DeleteRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_runtime_v0.VmRuntimeServiceClient)
+		client, ok := kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Delete(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.runtime.VmRuntimeService/v0.Delete"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.runtime.VmRuntimeService/v0.Delete"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
 
-func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Deploy(req *api_os_machine_runtime_v0.DeployRequest, ctxt *ApiServiceContext) error {
-	if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
-		return err
+func req_api_os_machine_runtime_v0_VmRuntimeService_v0_Deploy(req *api_os_machine_runtime_v0.DeployRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+	if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("os.machine.runtime.VmRuntimeService", "v0", req, ctxt); err != nil {
+		return nil, err
 	} else {
 		defer grpcCancel()
-		client, ok := ctxt.AddrClientMap[addr].(api_os_machine_runtime_v0.VmRuntimeServiceClient)
+		client, ok := kindClient.(api_os_machine_runtime_v0.VmRuntimeServiceClient)
 		if !ok {
-			return errors.New("no client for " + addr)
+			return nil, errors.New("no client for " + addr)
 		}
 		if resp, err := client.Deploy(grpcContext, req); err != nil {
-			return err
-		} else if handler := ctxt.RespHandlerMap["os.machine.runtime.VmRuntimeService/v0.Deploy"]; handler == nil {
-			return nil
+			return nil, err
+		} else if handler := ctxt.respHandlerMap["os.machine.runtime.VmRuntimeService/v0.Deploy"]; handler == nil {
+			return resp, nil
 		} else if err := handler(resp); err != nil {
-			return err
+			return nil, err
+		} else {
+			return resp, nil
 		}
 	}
-	return nil
 }
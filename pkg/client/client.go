@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"alt-os/api"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Default timeout of each call made without a deadline or an api timeout.
+const DEFAULT_TIMEOUT = 30 * time.Second
+
+// Prefix of the authorization metadata of calls that send a bearer token.
+const _BEARER_PREFIX = "Bearer "
+
+// Option configures a connection made by Dial.
+type Option func(*dialConf)
+
+// dialConf holds the configuration of a connection.
+type dialConf struct {
+	timeout   time.Duration
+	caFile    string
+	certFile  string
+	keyFile   string
+	token     string
+	tokenFile string
+	dialOpts  []grpc.DialOption
+}
+
+// WithTimeout sets the timeout of each call made without a deadline, which
+// must be positive since calls wait for the server to be ready.
+func WithTimeout(timeout time.Duration) Option {
+	return func(conf *dialConf) { conf.timeout = timeout }
+}
+
+// WithTLS connects using TLS, verifying the server against the CA certificate
+// file if not empty, and presenting the client certificate and key files if
+// not empty.
+func WithTLS(caFile, certFile, keyFile string) Option {
+	return func(conf *dialConf) { conf.caFile, conf.certFile, conf.keyFile = caFile, certFile, keyFile }
+}
+
+// WithToken sends the bearer token with each call.
+func WithToken(token string) Option {
+	return func(conf *dialConf) { conf.token = token }
+}
+
+// WithTokenFile sends the bearer token read from the file with each call.
+func WithTokenFile(filename string) Option {
+	return func(conf *dialConf) { conf.tokenFile = filename }
+}
+
+// WithDialOptions adds gRPC dial options to the connection.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(conf *dialConf) { conf.dialOpts = append(conf.dialOpts, opts...) }
+}
+
+// Conn is a connection to an API server, shared by the clients of the
+// services it serves.
+type Conn struct {
+	grpcConn *grpc.ClientConn
+}
+
+// Dial connects to the API server at the address, which is hostname:port, or
+// the path of a unix domain socket prefixed with "unix:". The connection is
+// made in the background, and calls wait for it until their deadline.
+func Dial(addr string, opts ...Option) (*Conn, error) {
+	conf := &dialConf{timeout: DEFAULT_TIMEOUT}
+	for _, opt := range opts {
+		opt(conf)
+	}
+	if conf.timeout <= 0 {
+		return nil, errors.New("timeout must be positive: " + conf.timeout.String())
+	}
+	if conf.tokenFile != "" {
+		if data, err := os.ReadFile(conf.tokenFile); err != nil {
+			return nil, err
+		} else {
+			conf.token = strings.TrimSpace(string(data))
+		}
+	}
+	creds, err := api.ClientCreds(addr, conf.caFile, conf.certFile, conf.keyFile)
+	if err != nil {
+		return nil, err
+	}
+	target, targetOpts := api.DialTarget(addr)
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: api.DefaultRetryBackoff}),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+		grpc.WithChainUnaryInterceptor(callUnaryInterceptor(conf), api.VersionUnaryClientInterceptor()),
+	}, targetOpts...)
+	grpcConn, err := grpc.Dial(target, append(dialOpts, conf.dialOpts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{grpcConn: grpcConn}, nil
+}
+
+// ClientConn returns the gRPC connection.
+func (conn *Conn) ClientConn() *grpc.ClientConn {
+	return conn.grpcConn
+}
+
+// Close closes the connection.
+func (conn *Conn) Close() error {
+	return conn.grpcConn.Close()
+}
+
+// callUnaryInterceptor returns a client interceptor that applies the api
+// timeout of the request, or else the timeout of the connection, to calls
+// without a deadline, sends the bearer token, and translates the status of
+// failed calls to errors.
+func callUnaryInterceptor(conf *dialConf) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+		timeout := conf.timeout
+		if timeoutReq, ok := req.(interface{ GetApiTimeout() uint32 }); ok && timeoutReq.GetApiTimeout() > 0 {
+			timeout = time.Duration(timeoutReq.GetApiTimeout()) * time.Second
+		}
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		if conf.token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, api.AUTHORIZATION_METADATA_KEY, _BEARER_PREFIX+conf.token)
+		}
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+			return statusError(method, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"alt-os/api"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestDialTimeout(t *testing.T) {
+	for _, timeout := range []time.Duration{0, -time.Second} {
+		if _, err := Dial("localhost:1", WithTimeout(timeout)); err == nil {
+			t.Errorf("dialing with timeout %v succeeded", timeout)
+		}
+	}
+	conn, err := Dial("localhost:1", WithTimeout(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+}
+
+func TestStatusError(t *testing.T) {
+	for code, codeErr := range codeErrors {
+		err := statusError("/os.machine.runtime.VmRuntimeService/Start", status.Error(code, "vm0"))
+		var callErr *Error
+		if !errors.As(err, &callErr) || callErr.Method != "Start" || callErr.Code != code || callErr.Message != "vm0" {
+			t.Errorf("%v: got error %#v, expected method Start and message vm0", code, err)
+		}
+		if !errors.Is(err, codeErr) || Code(err) != code {
+			t.Errorf("%v: got error %v, expected to match %v", code, err, codeErr)
+		}
+		for _, otherErr := range codeErrors {
+			if otherErr != codeErr && errors.Is(err, otherErr) {
+				t.Errorf("%v: error %v matches %v", code, err, otherErr)
+			}
+		}
+	}
+	err := statusError("/os.machine.runtime.VmRuntimeService/Start", errors.New("failed"))
+	if Code(err) != codes.Unknown || errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v, expected unknown", err)
+	}
+	if Code(errors.New("failed")) != codes.Unknown {
+		t.Error("got a code of an error not returned by a client")
+	}
+}
+
+// timeoutRequest is a request with an api timeout.
+type timeoutRequest struct{ timeout uint32 }
+
+func (req *timeoutRequest) GetApiTimeout() uint32 { return req.timeout }
+
+func TestCallUnaryInterceptor(t *testing.T) {
+	conf := &dialConf{timeout: time.Minute, token: "secret"}
+	interceptor := callUnaryInterceptor(conf)
+	call := func(ctx context.Context, req interface{}, invokeErr error) (time.Duration, []string, error) {
+		var timeout time.Duration
+		var auth []string
+		err := interceptor(ctx, "/os.machine.runtime.VmRuntimeService/List", req, nil, nil,
+			func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				if deadline, ok := ctx.Deadline(); ok {
+					timeout = time.Until(deadline)
+				}
+				md, _ := metadata.FromOutgoingContext(ctx)
+				auth = md.Get(api.AUTHORIZATION_METADATA_KEY)
+				return invokeErr
+			})
+		return timeout, auth, err
+	}
+
+	// The timeout of the connection applies to calls without a deadline.
+	timeout, auth, err := call(context.Background(), struct{}{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if timeout <= 0 || timeout > time.Minute {
+		t.Errorf("got timeout %v, expected at most a minute", timeout)
+	} else if len(auth) != 1 || auth[0] != _BEARER_PREFIX+"secret" {
+		t.Errorf("got authorization %v, expected the bearer token", auth)
+	}
+
+	// The api timeout of the request overrides it, and a deadline overrides both.
+	if timeout, _, _ := call(context.Background(), &timeoutRequest{timeout: 2}, nil); timeout <= time.Second || timeout > 2*time.Second {
+		t.Errorf("got timeout %v, expected the api timeout of 2s", timeout)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	if timeout, _, _ := call(ctx, &timeoutRequest{timeout: 2}, nil); timeout <= time.Minute {
+		t.Errorf("got timeout %v, expected the deadline of an hour", timeout)
+	}
+
+	// The status of failed calls is translated.
+	if _, _, err := call(context.Background(), struct{}{}, status.Error(codes.NotFound, "vm0")); !errors.Is(err, ErrNotFound) {
+		t.Errorf("got error %v, expected not found", err)
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright © 2022. All rights reserved.
+
+//
+// Package containing the connections and errors shared by the typed clients of
+// the OS API services, which are autogenerated by the `codegen` tool into a
+// subpackage per service.
+//
+package client
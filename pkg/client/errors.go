@@ -0,0 +1,76 @@
+package client
+
+import (
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors that the errors of failed calls match with errors.Is by their code.
+var (
+	ErrCanceled           = errors.New("canceled")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrDeadlineExceeded   = errors.New("deadline exceeded")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrResourceExhausted  = errors.New("resource exhausted")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrUnimplemented      = errors.New("unimplemented")
+	ErrUnavailable        = errors.New("unavailable")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+)
+
+// Errors matched by each status code.
+var codeErrors = map[codes.Code]error{
+	codes.Canceled:           ErrCanceled,
+	codes.InvalidArgument:    ErrInvalidArgument,
+	codes.DeadlineExceeded:   ErrDeadlineExceeded,
+	codes.NotFound:           ErrNotFound,
+	codes.AlreadyExists:      ErrAlreadyExists,
+	codes.PermissionDenied:   ErrPermissionDenied,
+	codes.ResourceExhausted:  ErrResourceExhausted,
+	codes.FailedPrecondition: ErrFailedPrecondition,
+	codes.Unimplemented:      ErrUnimplemented,
+	codes.Unavailable:        ErrUnavailable,
+	codes.Unauthenticated:    ErrUnauthenticated,
+}
+
+// Error is the error of a failed call, translated from its gRPC status.
+type Error struct {
+	Method  string     // Method called, e.g. Start.
+	Code    codes.Code // Status code of the call.
+	Message string     // Message of the status.
+}
+
+func (e *Error) Error() string {
+	return e.Method + ": " + e.Message + " (" + e.Code.String() + ")"
+}
+
+// Is returns whether the target is the error matched by the code of the error.
+func (e *Error) Is(target error) bool {
+	return target != nil && codeErrors[e.Code] == target
+}
+
+// statusError returns the error of a failed call of the full method name,
+// translated from its gRPC status.
+func statusError(fullMethod string, err error) error {
+	st := status.Convert(err)
+	return &Error{
+		Method:  fullMethod[strings.LastIndex(fullMethod, "/")+1:],
+		Code:    st.Code(),
+		Message: st.Message(),
+	}
+}
+
+// Code returns the status code of an error returned by a client, or
+// codes.Unknown if it is not the error of a failed call.
+func Code(err error) codes.Code {
+	var callErr *Error
+	if errors.As(err, &callErr) {
+		return callErr.Code
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,8 @@
+// Copyright © 2022. All rights reserved.
+
+//
+// Package containing the in-memory gRPC server shared by the fakes of the OS
+// API services, which are autogenerated by the `codegen` tool into the client
+// package of each service.
+//
+package fake
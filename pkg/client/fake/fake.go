@@ -0,0 +1,166 @@
+package fake
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sync"
+
+	"alt-os/api"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Size of the buffer of the in-memory listener of each server.
+const _BUFFER_SIZE = 1024 * 1024
+
+// Address that clients of a server dial, which the dialer of the server
+// connects to regardless.
+const ADDR = "bufconn"
+
+// Call is a call made to a fake service.
+type Call struct {
+	Method  string        // Method called, e.g. Start.
+	Request proto.Message // Request of the call.
+}
+
+// Handler handles the calls of a method, returning the response or error of
+// each call.
+type Handler func(ctx context.Context, req proto.Message) (proto.Message, error)
+
+// Server serves a fake service on an in-memory listener. It records each call
+// and returns the response programmed for its method, or else an empty
+// response.
+type Server struct {
+	listener   *bufconn.Listener
+	grpcServer *grpc.Server
+
+	mu        sync.Mutex
+	calls     []Call
+	responses map[string]proto.Message
+	errs      map[string]error
+	handlers  map[string]Handler
+}
+
+// NewServer starts serving the service registered by the function on an
+// in-memory listener.
+func NewServer(register func(grpcServer *grpc.Server)) *Server {
+	s := &Server{
+		listener:   bufconn.Listen(_BUFFER_SIZE),
+		grpcServer: grpc.NewServer(),
+		responses:  make(map[string]proto.Message),
+		errs:       make(map[string]error),
+		handlers:   make(map[string]Handler),
+	}
+	register(s.grpcServer)
+	go s.grpcServer.Serve(s.listener)
+	return s
+}
+
+// Stop stops the server, closing its connections.
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+// Dialer returns a dialer that connects to the server, whatever the target.
+func (s *Server) Dialer() func(context.Context, string) (net.Conn, error) {
+	return func(ctx context.Context, _ string) (net.Conn, error) {
+		return s.listener.DialContext(ctx)
+	}
+}
+
+// DialOption returns the dial option that connects clients to the server.
+func (s *Server) DialOption() grpc.DialOption {
+	return grpc.WithContextDialer(s.Dialer())
+}
+
+// Attach makes the clients of the context connect to the server instead of
+// the address, so that messages for the address are serviced by the fake.
+func (s *Server) Attach(ctxt *api.ApiServiceContext, addr string) {
+	ctxt.SetContextDialer(addr, s.Dialer())
+}
+
+// SetResponse programs the response of the calls of the method.
+func (s *Server) SetResponse(method string, resp proto.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses[method] = resp
+}
+
+// SetError programs the error of the calls of the method, which is returned
+// with its status if it has one, e.g. status.Error(codes.NotFound, "vm0"),
+// or with codes.Unknown otherwise. A nil error clears it.
+func (s *Server) SetError(method string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		delete(s.errs, method)
+	} else {
+		s.errs[method] = err
+	}
+}
+
+// SetHandler programs the handler of the calls of the method, which overrides
+// any programmed response or error. A nil handler clears it.
+func (s *Server) SetHandler(method string, handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if handler == nil {
+		delete(s.handlers, method)
+	} else {
+		s.handlers[method] = handler
+	}
+}
+
+// Calls returns the calls made to the server in order.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+// MethodCalls returns the calls made to the method in order.
+func (s *Server) MethodCalls(method string) []Call {
+	var calls []Call
+	for _, call := range s.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls made to the server and the programmed responses,
+// errors and handlers.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = nil
+	s.responses = make(map[string]proto.Message)
+	s.errs = make(map[string]error)
+	s.handlers = make(map[string]Handler)
+}
+
+// Handle records a call of the method and returns its programmed response or
+// error, or else the empty response, whose type the response must have.
+func (s *Server) Handle(ctx context.Context, method string, req, empty proto.Message) (proto.Message, error) {
+	s.mu.Lock()
+	s.calls = append(s.calls, Call{Method: method, Request: req})
+	handler, resp, err := s.handlers[method], s.responses[method], s.errs[method]
+	s.mu.Unlock()
+	if handler != nil {
+		resp, err = handler(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	} else if resp == nil {
+		return empty, nil
+	} else if reflect.TypeOf(resp) != reflect.TypeOf(empty) {
+		return nil, status.Errorf(codes.Internal, "fake response of %s is %T, not %T", method, resp, empty)
+	}
+	return resp, nil
+}
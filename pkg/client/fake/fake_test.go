@@ -0,0 +1,44 @@
+package fake_test
+
+import (
+	"alt-os/api"
+	api_os_machine_runtime_v0 "alt-os/api/os/machine/runtime/v0"
+	"alt-os/client/vmruntime"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAttach(t *testing.T) {
+	const addr = "localhost:8889"
+	f := vmruntime.NewFake()
+	defer f.Stop()
+	ctxt := api.InitContext(map[string]interface{}{}, map[string]func(interface{}) error{})
+	f.Attach(ctxt, addr)
+	msg := &api.ApiProtoMessage{
+		Kind:    "os.machine.runtime.ListRequest",
+		Version: "v0",
+		Def:     &api_os_machine_runtime_v0.ListRequest{ApiHostname: "localhost", ApiPort: 8889},
+	}
+
+	// Messages for the address are serviced by the fake.
+	f.SetResponse("List", &api_os_machine_runtime_v0.ListResponse{Id: []string{"vm0"}})
+	resp, err := api.RequestApiProtoMessage(msg, ctxt)
+	if err != nil {
+		t.Fatal(err)
+	} else if list, ok := resp.Def.(*api_os_machine_runtime_v0.ListResponse); !ok ||
+		!reflect.DeepEqual(list.Id, []string{"vm0"}) {
+		t.Errorf("got response %v, expected ids [vm0]", resp.Def)
+	}
+	if calls := f.Calls(); len(calls) != 1 || calls[0].Method != "List" {
+		t.Errorf("got calls %v, expected one of List", calls)
+	}
+
+	f.SetError("List", status.Error(codes.NotFound, "vm1"))
+	if _, err := api.RequestApiProtoMessage(msg, ctxt); err == nil || !strings.Contains(err.Error(), "vm1") {
+		t.Errorf("got error %v, expected vm1", err)
+	}
+}
@@ -0,0 +1,44 @@
+package vmruntime
+
+import (
+	api_os_machine_runtime_v0 "alt-os/api/os/machine/runtime/v0"
+	"alt-os/client"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClient(t *testing.T) {
+	f := NewFake()
+	defer f.Stop()
+	c, err := f.Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	ctx := context.Background()
+
+	f.SetResponse("List", &api_os_machine_runtime_v0.ListResponse{Id: []string{"vm0"}})
+	if resp, err := c.List(ctx, &api_os_machine_runtime_v0.ListRequest{}); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(resp.Id, []string{"vm0"}) {
+		t.Errorf("got ids %v, expected [vm0]", resp.Id)
+	}
+
+	f.SetError("Start", status.Error(codes.NotFound, "vm1"))
+	err = c.Start(ctx, &api_os_machine_runtime_v0.StartRequest{Id: "vm1"})
+	var callErr *client.Error
+	if !errors.Is(err, client.ErrNotFound) || client.Code(err) != codes.NotFound {
+		t.Errorf("got error %v, expected not found", err)
+	} else if !errors.As(err, &callErr) || callErr.Method != "Start" || callErr.Message != "vm1" {
+		t.Errorf("got error %#v, expected method Start and message vm1", callErr)
+	}
+	if calls := f.MethodCalls("Start"); len(calls) != 1 ||
+		calls[0].Request.(*api_os_machine_runtime_v0.StartRequest).Id != "vm1" {
+		t.Errorf("got Start calls %v, expected one of vm1", calls)
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"alt-os/api"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Name of the command that queries audit journals.
+const _AUDIT_COMMAND = "audit"
+
+// runAudit queries an audit journal file with the arguments of the audit
+// command and prints the matching records in the format.
+func runAudit(args []string, format string) error {
+	var filename, id, since, until string
+	flags := flag.NewFlagSet(_AUDIT_COMMAND, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Queries the audit journal of a service.\n")
+		fmt.Fprintf(os.Stderr, "Usage: altctl [options] %s [flags]\n", _AUDIT_COMMAND)
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&filename, "f", "", "The audit journal file to query, "+api.AUDIT_JOURNAL_FILENAME+
+		" in the root directory of the service")
+	flags.StringVar(&id, "id", "", "Only records of requests operating on the object with the id")
+	flags.StringVar(&since, "since", "", "Only records from the time (RFC 3339), or the duration before now")
+	flags.StringVar(&until, "until", "", "Only records until the time (RFC 3339), or the duration before now")
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	} else if err != nil {
+		os.Exit(1)
+	} else if filename == "" {
+		flags.Usage()
+		os.Exit(1)
+	}
+	sinceTime, err := parseAuditTime(since)
+	if err != nil {
+		return err
+	}
+	untilTime, err := parseAuditTime(until)
+	if err != nil {
+		return err
+	}
+	records, err := api.QueryAuditJournal(filename, id, sinceTime, untilTime)
+	if err != nil {
+		return err
+	}
+
+	// Print each record as a json line, or all records as a yaml list.
+	switch format {
+	default:
+		return errors.New("unrecognized format: " + format)
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		for _, record := range records {
+			if err := encoder.Encode(record); err != nil {
+				return err
+			}
+		}
+	case "yml", "yaml":
+		var values []interface{}
+		if data, err := json.Marshal(records); err != nil {
+			return err
+		} else if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+		encoder := yaml.NewEncoder(os.Stdout)
+		defer encoder.Close()
+		if len(values) > 0 {
+			return encoder.Encode(values)
+		}
+	}
+	return nil
+}
+
+// parseAuditTime parses a time as RFC 3339 or as a duration before now, or
+// returns the zero time if the string is empty.
+func parseAuditTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	} else if duration, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-duration), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Types of the fields of a command, which determine how flag values are parsed.
+type altctlFieldType int
+
+const (
+	_FIELD_STRING altctlFieldType = iota
+	_FIELD_BOOL
+	_FIELD_INT
+	_FIELD_UINT
+	_FIELD_FLOAT
+	_FIELD_ENUM
+	_FIELD_BYTES
+	_FIELD_MESSAGE // Values are yaml or json.
+)
+
+// altctlCommand describes the command of a single service method.
+type altctlCommand struct {
+	path    []string // Subcommand names, e.g. machine runtime start.
+	kind    string   // Kind of the request message.
+	version string
+	usage   string
+	fields  []*altctlField
+}
+
+// altctlField describes a field of the request message of a command.
+type altctlField struct {
+	name      string // Json name of the field.
+	fieldType altctlFieldType
+	repeated  bool
+	usage     string
+}
+
+// findCommand returns the command whose path is the longest prefix of the
+// arguments, and the remaining arguments.
+func findCommand(args []string) (*altctlCommand, []string) {
+	var found *altctlCommand
+	for _, command := range altctlCommands {
+		if len(command.path) > len(args) || (found != nil && len(found.path) >= len(command.path)) {
+			continue
+		}
+		if strings.Join(command.path, " ") == strings.Join(args[:len(command.path)], " ") {
+			found = command
+		}
+	}
+	if found == nil {
+		return nil, args
+	}
+	return found, args[len(found.path):]
+}
+
+// hasField returns whether the request message of the command has the field.
+func (command *altctlCommand) hasField(name string) bool {
+	for _, field := range command.fields {
+		if field.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// flagSet returns a flag set of the command that sets the fields of the request
+// definition def, which is keyed by json field name.
+func (command *altctlCommand) flagSet(def map[string]interface{}) *flag.FlagSet {
+	flags := flag.NewFlagSet(strings.Join(command.path, " "), flag.ContinueOnError)
+	for _, field := range command.fields {
+		usage := field.usage
+		if field.repeated {
+			usage += " (repeatable)"
+		}
+		flags.Var(&altctlFieldValue{field: field, def: def}, flagName(field.name), usage)
+	}
+	return flags
+}
+
+// flagName returns the flag name of a field, which is its json name in lower
+// kebab case.
+func flagName(jsonName string) string {
+	var b strings.Builder
+	for _, c := range jsonName {
+		if c >= 'A' && c <= 'Z' {
+			b.WriteByte('-')
+			c += 'a' - 'A'
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// altctlFieldValue implements a flag.Value that sets a field of the request
+// definition.
+type altctlFieldValue struct {
+	field *altctlField
+	def   map[string]interface{}
+}
+
+func (v *altctlFieldValue) String() string {
+	return ""
+}
+
+func (v *altctlFieldValue) Set(s string) error {
+	value, err := v.field.parse(s)
+	if err != nil {
+		return err
+	}
+	if v.field.repeated {
+		values, _ := v.def[v.field.name].([]interface{})
+		v.def[v.field.name] = append(values, value)
+	} else {
+		v.def[v.field.name] = value
+	}
+	return nil
+}
+
+// IsBoolFlag allows single bool fields to be set without a value.
+func (v *altctlFieldValue) IsBoolFlag() bool {
+	return v.field.fieldType == _FIELD_BOOL && !v.field.repeated
+}
+
+// parse converts a flag value to the json value of the field.
+func (field *altctlField) parse(s string) (interface{}, error) {
+	switch field.fieldType {
+	case _FIELD_BOOL:
+		return strconv.ParseBool(s)
+	case _FIELD_INT:
+		if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, err
+		}
+		return json.Number(s), nil
+	case _FIELD_UINT:
+		if _, err := strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, err
+		}
+		return json.Number(s), nil
+	case _FIELD_FLOAT:
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return nil, err
+		}
+		return json.Number(s), nil
+	case _FIELD_MESSAGE:
+		var value interface{}
+		if err := yaml.Unmarshal([]byte(s), &value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	}
+	return s, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variable naming the config file to use if none is specified.
+const _CONFIG_ENV = "ALTCTL_CONFIG"
+
+// Path of the default config file relative to the user config directory.
+const _DEFAULT_CONFIG_PATH = "alt-os/altctl.yml"
+
+// AltctlConf holds the connection configuration read from the config file.
+// Each entry is the default value of a request field, keyed by json name, and
+// is used for requests with that field unless set by a flag.
+type AltctlConf struct {
+	Defaults map[string]interface{}            `yaml:"defaults"` // Defaults of all requests.
+	Services map[string]map[string]interface{} `yaml:"services"` // Defaults by proto package.
+}
+
+// Configuration used if there is no config file, matching the definitions of
+// the service executables.
+var defaultAltctlConf = &AltctlConf{
+	Defaults: map[string]interface{}{
+		"apiHostname": "localhost",
+		"apiTimeout":  10,
+	},
+	Services: map[string]map[string]interface{}{
+		"os.machine.image":     {"apiPort": 8888},
+		"os.machine.runtime":   {"apiPort": 8889},
+		"os.container.bundle":  {"apiPort": 8890},
+		"os.container.runtime": {"apiPort": 8891},
+	},
+}
+
+// readAltctlConf reads the config file. If filename is empty, the file named
+// by the environment is used, or the default file if it exists, or else the
+// default configuration.
+func readAltctlConf(filename string) (*AltctlConf, error) {
+	if filename == "" {
+		filename = os.Getenv(_CONFIG_ENV)
+	}
+	if filename == "" {
+		if dir, err := os.UserConfigDir(); err != nil {
+			return defaultAltctlConf, nil
+		} else if filename = filepath.Join(dir, _DEFAULT_CONFIG_PATH); !fileExists(filename) {
+			return defaultAltctlConf, nil
+		}
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	conf := &AltctlConf{}
+	if err := yaml.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// fileExists returns whether a file exists at the path.
+func fileExists(filename string) bool {
+	_, err := os.Stat(filename)
+	return err == nil
+}
+
+// requestDefaults returns the default fields of requests of the command.
+func (conf *AltctlConf) requestDefaults(command *altctlCommand) map[string]interface{} {
+	def := make(map[string]interface{})
+	packageName := command.kind[:strings.LastIndex(command.kind, ".")]
+	for _, defaults := range []map[string]interface{}{conf.Defaults, conf.Services[packageName]} {
+		for name, value := range defaults {
+			if command.hasField(name) {
+				def[name] = value
+			}
+		}
+	}
+	return def
+}
@@ -0,0 +1,6 @@
+// Copyright © 2022. All rights reserved.
+
+//
+// Command-line client executable for the OS services.
+//
+package main
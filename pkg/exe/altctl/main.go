@@ -0,0 +1,89 @@
+package main
+
+import (
+	"alt-os/api"
+	"alt-os/exe"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const EXE_USAGE = `altctl
+------
+Command-line client for the OS services.
+
+Sends a single request to a service and prints the response. Each service
+method has a subcommand, e.g. altctl machine runtime start --id os1, with a
+flag for each field of its request. Connection fields default to the values
+of the config file. The audit command queries the audit journal of a service.
+`
+
+// main is the entry point.
+func main() {
+
+	// Parse command line.
+	var confFile, format string
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "%s\n", EXE_USAGE)
+		fmt.Fprintf(os.Stderr, "Usage: altctl [options] <command> [flags]\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nCommands:\n")
+		fmt.Fprintf(os.Stderr, "  %s\n    \t%s\n", _AUDIT_COMMAND, "Queries the audit journal of a service.")
+		for _, command := range altctlCommands {
+			fmt.Fprintf(os.Stderr, "  %s\n    \t%s\n", strings.Join(command.path, " "), command.usage)
+		}
+	}
+	flag.StringVar(&confFile, "c", "", "The config file to use, or $"+_CONFIG_ENV+" or ~/.config/"+
+		_DEFAULT_CONFIG_PATH+" if not specified")
+	flag.StringVar(&format, "o", "yaml", "Output format (yaml,json)")
+	flag.Parse()
+
+	if flag.NArg() > 0 && flag.Arg(0) == _AUDIT_COMMAND {
+		if err := runAudit(flag.Args()[1:], format); err != nil {
+			exe.Fatal("querying audit journal", err, &exe.ExeContext{})
+		}
+		os.Exit(0)
+	}
+	command, args := findCommand(flag.Args())
+	if command == nil {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	// Build the request from the config and the flags of the command.
+	ctxt := exe.NewExeContext(nil, nil, &exe.LoggerConf{ExeTag: "altctl"})
+	conf, err := readAltctlConf(confFile)
+	if err != nil {
+		exe.Fatal("reading config", err, ctxt)
+	}
+	def := conf.requestDefaults(command)
+	flags := command.flagSet(def)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "%s\n", command.usage)
+		fmt.Fprintf(os.Stderr, "Usage: altctl [options] %s [flags]\n", flags.Name())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	} else if err != nil {
+		os.Exit(1)
+	} else if flags.NArg() > 0 {
+		exe.Fatal("parsing flags", errors.New("unexpected arguments: "+strings.Join(flags.Args(), " ")), ctxt)
+	}
+	msg, err := api.NewApiProtoMessage(command.kind, command.version, def)
+	if err != nil {
+		exe.Fatal("building request", err, ctxt)
+	}
+
+	// Send the request and print the response.
+	if resp, err := api.RequestApiProtoMessage(msg, ctxt.ApiServiceContext); err != nil {
+		exe.Fatal(flags.Name(), err, ctxt)
+	} else if resp != nil {
+		if err := api.MarshalApiProtoMessages([]*api.ApiProtoMessage{resp}, "-", format); err != nil {
+			exe.Fatal("writing response", err, ctxt)
+		}
+	}
+	exe.Success(ctxt)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Defaults of the daemon configuration.
+const (
+	_DEFAULT_LOG_LEVEL   = "info"
+	_DEFAULT_HOSTNAME    = "localhost"
+	_DEFAULT_API_TIMEOUT = 10
+)
+
+// AltdConf holds the daemon configuration read from the config file.
+type AltdConf struct {
+	LogLevel   string                      `yaml:"logLevel"`
+	Hostname   string                      `yaml:"hostname"`   // Default hostname of the services.
+	ApiTimeout uint32                      `yaml:"apiTimeout"` // Default API timeout of the services.
+	Services   map[string]*AltdServiceConf `yaml:"services"`   // Service configuration by service name.
+}
+
+// AltdServiceConf holds the configuration of a single service of the daemon.
+type AltdServiceConf struct {
+	Enabled  bool   `yaml:"enabled"`
+	Hostname string `yaml:"hostname"`
+	Port     uint32 `yaml:"port"`
+	Socket   string `yaml:"socket"` // Unix socket to serve on instead of a TCP port.
+	// Other fields of the ApiServeRequest of the service, by json name.
+	Serve map[string]interface{} `yaml:"serve"`
+}
+
+// readAltdConf reads the daemon configuration file and checks that it only
+// configures known services.
+func readAltdConf(filename string) (*AltdConf, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	conf := &AltdConf{}
+	if err := yaml.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+	for name := range conf.Services {
+		if findAltdService(name) == nil {
+			return nil, errors.New("unknown service: " + name)
+		}
+	}
+	if conf.LogLevel == "" {
+		conf.LogLevel = _DEFAULT_LOG_LEVEL
+	}
+	if conf.Hostname == "" {
+		conf.Hostname = _DEFAULT_HOSTNAME
+	}
+	if conf.ApiTimeout == 0 {
+		conf.ApiTimeout = _DEFAULT_API_TIMEOUT
+	}
+	return conf, nil
+}
+
+// serveDef returns the definition fields of the ApiServeRequest of the service.
+func (conf *AltdConf) serveDef(service *altdService) map[string]interface{} {
+	serviceConf := conf.Services[service.name]
+	def := map[string]interface{}{
+		"apiHostname": conf.Hostname,
+		"apiPort":     service.defaultPort,
+		"apiTimeout":  conf.ApiTimeout,
+	}
+	for key, value := range serviceConf.Serve {
+		def[key] = value
+	}
+	if serviceConf.Hostname != "" {
+		def["apiHostname"] = serviceConf.Hostname
+	}
+	if serviceConf.Port != 0 {
+		def["apiPort"] = serviceConf.Port
+	}
+	if serviceConf.Socket != "" {
+		def["apiSocket"] = serviceConf.Socket
+	}
+	return def
+}
@@ -0,0 +1,6 @@
+// Copyright © 2022. All rights reserved.
+
+//
+// Daemon executable hosting the OS services in a single process.
+//
+package main
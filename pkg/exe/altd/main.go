@@ -0,0 +1,172 @@
+package main
+
+import (
+	"alt-os/api"
+	"alt-os/exe"
+	"alt-os/exe/service/ctbundle"
+	"alt-os/exe/service/ctruntime"
+	"alt-os/exe/service/vmimage"
+	"alt-os/exe/service/vmruntime"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+)
+
+const EXE_USAGE = `altd
+----
+OS services daemon executable.
+
+Hosts the enabled OS services in a single process, sharing one context so that
+the services can call each other in-process. The services and their ports are
+read from the daemon config file.
+`
+
+// altdService describes a service that the daemon can host.
+type altdService struct {
+	name        string // Name of the service in the config file.
+	pkg         string // Proto package of the service messages.
+	defaultPort uint32
+	// Returns the server-impls and response handlers of the service, and a
+	// function that sets the ExeContext of the service context.
+	newImpls func() (map[string]interface{}, map[string]func(interface{}) error, func(*exe.ExeContext))
+}
+
+// Services that the daemon can host.
+var altdServices = []*altdService{
+	{
+		name:        "vm-image",
+		pkg:         "os.machine.image",
+		defaultPort: 8888,
+		newImpls: func() (map[string]interface{}, map[string]func(interface{}) error, func(*exe.ExeContext)) {
+			ctxt := &vmimage.VmImageContext{}
+			return vmimage.KindImplMap(ctxt), nil, func(exeCtxt *exe.ExeContext) { ctxt.ExeContext = exeCtxt }
+		},
+	},
+	{
+		name:        "vm-runtime",
+		pkg:         "os.machine.runtime",
+		defaultPort: 8889,
+		newImpls: func() (map[string]interface{}, map[string]func(interface{}) error, func(*exe.ExeContext)) {
+			ctxt := vmruntime.NewVmRuntimeContext()
+			return vmruntime.KindImplMap(ctxt), nil, func(exeCtxt *exe.ExeContext) { ctxt.ExeContext = exeCtxt }
+		},
+	},
+	{
+		name:        "ct-bundle",
+		pkg:         "os.container.bundle",
+		defaultPort: 8890,
+		newImpls: func() (map[string]interface{}, map[string]func(interface{}) error, func(*exe.ExeContext)) {
+			ctxt := &ctbundle.CtBundleContext{}
+			return ctbundle.KindImplMap(ctxt), nil, func(exeCtxt *exe.ExeContext) { ctxt.ExeContext = exeCtxt }
+		},
+	},
+	{
+		name:        "ct-runtime",
+		pkg:         "os.container.runtime",
+		defaultPort: 8891,
+		newImpls: func() (map[string]interface{}, map[string]func(interface{}) error, func(*exe.ExeContext)) {
+			ctxt := &ctruntime.CtRuntimeContext{}
+			return ctruntime.KindImplMap(ctxt), ctruntime.RespHandlerMap(),
+				func(exeCtxt *exe.ExeContext) { ctxt.ExeContext = exeCtxt }
+		},
+	},
+}
+
+// findAltdService returns the service with the name, or nil if there is none.
+func findAltdService(name string) *altdService {
+	for _, service := range altdServices {
+		if service.name == name {
+			return service
+		}
+	}
+	return nil
+}
+
+// main is the entry point.
+func main() {
+
+	// Parse command line.
+	var confFile string
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "%s\n", EXE_USAGE)
+		fmt.Fprintf(os.Stderr, "Usage:\n")
+		flag.PrintDefaults()
+	}
+	flag.StringVar(&confFile, "c", "", "The daemon config file to use")
+	flag.Parse()
+
+	if confFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	// Read the config and collect the server-impls of the enabled services.
+	conf, err := readAltdConf(confFile)
+	if err != nil {
+		exe.Fatal("reading config", err, &exe.ExeContext{})
+	}
+	kindImplMap := make(map[string]interface{})
+	respHandlerMap := make(map[string]func(interface{}) error)
+	var enabled []*altdService
+	var setExeContexts []func(*exe.ExeContext)
+	for _, service := range altdServices {
+		if serviceConf, ok := conf.Services[service.name]; !ok || !serviceConf.Enabled {
+			continue
+		}
+		impls, respHandlers, setExeContext := service.newImpls()
+		for kindVer, impl := range impls {
+			kindImplMap[kindVer] = impl
+		}
+		for method, handler := range respHandlers {
+			respHandlerMap[method] = handler
+		}
+		enabled = append(enabled, service)
+		setExeContexts = append(setExeContexts, setExeContext)
+	}
+	if len(enabled) == 0 {
+		exe.Fatal("reading config", errors.New("no enabled services: "+confFile), &exe.ExeContext{})
+	}
+
+	// Initialize the shared context and queue a serve message for each service.
+	loggerConf := &exe.LoggerConf{
+		Enabled:    true,
+		Level:      conf.LogLevel,
+		ExeTag:     "altd",
+		FormatJson: false,
+	}
+	ctxt := exe.NewExeContext(kindImplMap, respHandlerMap, loggerConf)
+	for _, setExeContext := range setExeContexts {
+		setExeContext(ctxt)
+	}
+	for _, service := range enabled {
+		kind := service.pkg + ".ApiServeRequest"
+		version, _ := api.ServedKindVersion(kind, kindImplMap)
+		if msg, err := api.NewApiProtoMessage(kind, version, conf.serveDef(service)); err != nil {
+			exe.Fatal("configuring "+service.name, err, ctxt)
+		} else {
+			msg.Id = service.name
+			ctxt.MessageQueue = append(ctxt.MessageQueue, msg)
+		}
+	}
+
+	// Stop serving all services on interrupt.
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		for _, addr := range ctxt.ServerAddrs() {
+			for _, kindVer := range ctxt.ServedKindVers(addr) {
+				ctxt.SignalStop(addr, strings.SplitN(kindVer, "/", 2)[0])
+			}
+		}
+	}()
+
+	if err := api.ServiceMessages(ctxt.ApiServiceContext); err != nil {
+		exe.Fatal("servicing messages", err, ctxt)
+	}
+	exe.Success(ctxt)
+}
@@ -8,3 +8,20 @@ type ExeContext struct {
 	ExeLoggerConf *LoggerConf
 	CleanupFuncs  []func() // Functions that must run at exit.
 }
+
+// NewExeContext returns an ExeContext serving the server-impls of kindImplMap
+// with the standard gRPC interceptors, and no queued messages.
+func NewExeContext(kindImplMap map[string]interface{}, respHandlerMap map[string]func(interface{}) error,
+	loggerConf *LoggerConf) *ExeContext {
+
+	ctxt := &ExeContext{
+		ApiServiceContext: api.InitContext(kindImplMap, respHandlerMap),
+		ExeLoggerConf:     loggerConf,
+	}
+	logger := NewLogger(loggerConf)
+	ctxt.ApiServiceContext.UnaryServerInterceptors = ServerUnaryInterceptors(logger)
+	ctxt.ApiServiceContext.StreamServerInterceptors = ServerStreamInterceptors(logger)
+	ctxt.ApiServiceContext.UnaryClientInterceptors = ClientUnaryInterceptors()
+	ctxt.ApiServiceContext.StreamClientInterceptors = ClientStreamInterceptors()
+	return ctxt
+}
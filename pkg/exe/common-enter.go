@@ -7,22 +7,44 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 )
 
-// Parses command line parameters and initializes an ExeContext.
+// DefineVarsFlag implements a repeatable flag.Value that sets definition
+// variables from key=value arguments.
+type DefineVarsFlag struct{}
+
+func (f DefineVarsFlag) String() string {
+	return ""
+}
+
+func (f DefineVarsFlag) Set(value string) error {
+	if keyValue := strings.SplitN(value, "=", 2); len(keyValue) != 2 || keyValue[0] == "" {
+		return errors.New("expected key=value: " + value)
+	} else {
+		api.SetDefinitionVar(keyValue[0], keyValue[1])
+	}
+	return nil
+}
+
+// Parses command line parameters and initializes an ExeContext. Input messages
+// are sent as the version they were read as, which servers of newer versions
+// upconvert.
 func InitContext(cmdUsage string, allowedKindRe *regexp.Regexp,
-	allowedVersionRe *regexp.Regexp, kindImplMap map[string]interface{},
-	respHandlerMap map[string]func(interface{}) error, loggerConf *LoggerConf) *ExeContext {
+	kindImplMap map[string]interface{}, respHandlerMap map[string]func(interface{}) error,
+	loggerConf *LoggerConf) *ExeContext {
 
 	// Parse command line.
-	var infile, format string
+	var infile, format, outfile string
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "%s\n", cmdUsage)
 		fmt.Fprintf(os.Stderr, "Usage:\n")
 		flag.PrintDefaults()
 	}
-	flag.StringVar(&infile, "i", "", "The input object(s) file to use for container runtime changes")
-	flag.StringVar(&format, "f", "", "Input file format (yaml,json), inferred from extension if not specified")
+	flag.StringVar(&infile, "i", "", "The input object(s) file to use for container runtime changes (- for stdin)")
+	flag.StringVar(&format, "f", "", "Input file format (yaml,json,pb,textproto), inferred from extension if not specified")
+	flag.StringVar(&outfile, "o", "", "The output file to write responses to (- for stdout), format inferred from extension")
+	flag.Var(DefineVarsFlag{}, "D", "Sets a key=value variable to substitute for ${key} in input files (repeatable)")
 	flag.Parse()
 
 	if infile == "" {
@@ -31,10 +53,7 @@ func InitContext(cmdUsage string, allowedKindRe *regexp.Regexp,
 	}
 
 	// Read and verify input file and initialize context.
-	ctxt := &ExeContext{
-		ApiServiceContext: api.InitContext(kindImplMap, respHandlerMap),
-		ExeLoggerConf:     loggerConf,
-	}
+	ctxt := NewExeContext(kindImplMap, respHandlerMap, loggerConf)
 	if messages, err := api.UnmarshalApiProtoMessages(infile, format); err != nil {
 		Fatal("unmarshaling proto messages", err, ctxt)
 	} else {
@@ -42,11 +61,12 @@ func InitContext(cmdUsage string, allowedKindRe *regexp.Regexp,
 			if !allowedKindRe.MatchString(msg.Kind) {
 				Fatal("parsing input", errors.New("bad object kind: "+msg.Kind), ctxt)
 			}
-			if !allowedVersionRe.MatchString(msg.Version) {
-				Fatal("parsing input", errors.New("bad object version: "+msg.Version), ctxt)
+			if _, ok := api.ServedKindVersion(msg.Kind, kindImplMap); !ok {
+				Fatal("parsing input", errors.New("no served version of object kind: "+msg.Kind), ctxt)
 			}
 		}
 		ctxt.ApiServiceContext.MessageQueue = messages
+		ctxt.ApiServiceContext.OutputFile = outfile
 	}
 
 	return ctxt
@@ -0,0 +1,193 @@
+package exe
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata key of the request ID sent with each gRPC call.
+const REQUEST_ID_METADATA_KEY = "x-request-id"
+
+// Number of random bytes in a generated request ID.
+const _REQUEST_ID_BYTES = 8
+
+// requestIdKey is the context key of the request ID of a gRPC call.
+type requestIdKey struct{}
+
+// RequestId returns the request ID of the gRPC call of the context, or an
+// empty string if there is none.
+func RequestId(ctx context.Context) string {
+	if requestId, ok := ctx.Value(requestIdKey{}).(string); ok {
+		return requestId
+	}
+	return ""
+}
+
+// ServerUnaryInterceptors returns the standard chain of unary interceptors for
+// API servers, which assign request IDs, log calls, and recover from panics.
+func ServerUnaryInterceptors(logger Logger) []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler) (interface{}, error) {
+
+			ctx = serverRequestIdContext(ctx)
+			start := time.Now()
+			resp, err := recoverUnary(ctx, req, info.FullMethod, handler, logger)
+			logCall(ctx, logger, info.FullMethod, start, err)
+			return resp, err
+		},
+	}
+}
+
+// ServerStreamInterceptors returns the standard chain of stream interceptors for
+// API servers, which assign request IDs, log calls, and recover from panics.
+func ServerStreamInterceptors(logger Logger) []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+			handler grpc.StreamHandler) error {
+
+			ctx := serverRequestIdContext(ss.Context())
+			start := time.Now()
+			err := recoverStream(srv, &requestIdServerStream{ServerStream: ss, ctx: ctx},
+				info.FullMethod, handler, logger)
+			logCall(ctx, logger, info.FullMethod, start, err)
+			return err
+		},
+	}
+}
+
+// ClientUnaryInterceptors returns the standard chain of unary interceptors for
+// API clients, which send a new request ID with each call.
+func ClientUnaryInterceptors() []grpc.UnaryClientInterceptor {
+	return []grpc.UnaryClientInterceptor{
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+			invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+			return invoker(clientRequestIdContext(ctx), method, req, reply, cc, opts...)
+		},
+	}
+}
+
+// ClientStreamInterceptors returns the standard chain of stream interceptors for
+// API clients, which send a new request ID with each call.
+func ClientStreamInterceptors() []grpc.StreamClientInterceptor {
+	return []grpc.StreamClientInterceptor{
+		func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+			streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+
+			return streamer(clientRequestIdContext(ctx), desc, cc, method, opts...)
+		},
+	}
+}
+
+// requestIdServerStream wraps a server stream to use a context with a request ID.
+type requestIdServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *requestIdServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+// serverRequestIdContext returns a context with the request ID sent by the
+// client, or a new request ID if none was sent. The ID is also sent back to
+// the client in the response header.
+func serverRequestIdContext(ctx context.Context) context.Context {
+	requestId := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(REQUEST_ID_METADATA_KEY); len(values) > 0 {
+			requestId = values[0]
+		}
+	}
+	if requestId == "" {
+		requestId = newRequestId()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(REQUEST_ID_METADATA_KEY, requestId))
+	return context.WithValue(ctx, requestIdKey{}, requestId)
+}
+
+// clientRequestIdContext returns a context that sends a new request ID with
+// the call unless one is already set.
+func clientRequestIdContext(ctx context.Context) context.Context {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(REQUEST_ID_METADATA_KEY)) > 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, REQUEST_ID_METADATA_KEY, newRequestId())
+}
+
+// newRequestId returns a new random request ID.
+func newRequestId() string {
+	data := make([]byte, _REQUEST_ID_BYTES)
+	if _, err := rand.Read(data); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(data)
+}
+
+// recoverUnary calls the unary handler and turns any panic into an internal error.
+func recoverUnary(ctx context.Context, req interface{}, method string, handler grpc.UnaryHandler,
+	logger Logger) (resp interface{}, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			logPanic(ctx, logger, method, r)
+			resp, err = nil, status.Errorf(codes.Internal, "panic in %s", method)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// recoverStream calls the stream handler and turns any panic into an internal error.
+func recoverStream(srv interface{}, ss grpc.ServerStream, method string, handler grpc.StreamHandler,
+	logger Logger) (err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			logPanic(ss.Context(), logger, method, r)
+			err = status.Errorf(codes.Internal, "panic in %s", method)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// callFields returns the log fields identifying the gRPC call of the context.
+func callFields(ctx context.Context, method string) Fields {
+	fields := Fields{
+		"method":    method,
+		"requestId": RequestId(ctx),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields["peer"] = p.Addr.String()
+	}
+	return fields
+}
+
+// logCall logs the completion of a gRPC call.
+func logCall(ctx context.Context, logger Logger, method string, start time.Time, err error) {
+	fields := callFields(ctx, method)
+	fields["duration"] = time.Since(start).String()
+	fields["code"] = status.Code(err).String()
+	if err != nil {
+		logger.WithFields(fields).Warn("rpc failed: " + err.Error())
+	} else {
+		logger.WithFields(fields).Info("rpc")
+	}
+}
+
+// logPanic logs a panic recovered from a gRPC handler.
+func logPanic(ctx context.Context, logger Logger, method string, r interface{}) {
+	fields := callFields(ctx, method)
+	fields["stack"] = string(debug.Stack())
+	logger.WithFields(fields).Error(fmt.Sprintf("panic: %v", r))
+}
@@ -43,25 +43,25 @@ func (sl _Logger) WithFields(fields Fields) Logger {
 
 func (sl _Logger) Trace(args ...interface{}) {
 	if sl.enabled {
-		sl.log.Trace(args)
+		sl.log.Trace(args...)
 	}
 }
 
 func (sl _Logger) Info(args ...interface{}) {
 	if sl.enabled {
-		sl.log.Info(args)
+		sl.log.Info(args...)
 	}
 }
 
 func (sl _Logger) Warn(args ...interface{}) {
 	if sl.enabled {
-		sl.log.Warn(args)
+		sl.log.Warn(args...)
 	}
 }
 
 func (sl _Logger) Error(args ...interface{}) {
 	if sl.enabled {
-		sl.log.Error(args)
+		sl.log.Error(args...)
 	}
 }
 
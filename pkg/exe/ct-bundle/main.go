@@ -3,6 +3,7 @@ package main
 import (
 	"alt-os/api"
 	"alt-os/exe"
+	"alt-os/exe/service/ctbundle"
 	"regexp"
 )
 
@@ -14,20 +15,11 @@ Manages container bundles in accordance with the OCI bundle specification:
 https://github.com/opencontainers/runtime-spec/blob/v1.0.2/bundle.md.
 `
 
-// CtBundleContext holds context information for ct-bundle.
-type CtBundleContext struct {
-	*exe.ExeContext
-	rootDir string // Stores the root directory of all bundle subdirectories.
-}
-
 // main is the entry point.
 func main() {
 	allowedKindRe := regexp.MustCompile(`os.container.bundle.[[:word:]]`)
-	allowedVersionRe := regexp.MustCompile(`v0`)
-	ctxt := &CtBundleContext{}
-	kindImplMap := map[string]interface{}{
-		"os.container.bundle.ContainerBundleService/v0": newContainerBundleServiceServerImpl(ctxt),
-	}
+	ctxt := &ctbundle.CtBundleContext{}
+	kindImplMap := ctbundle.KindImplMap(ctxt)
 	respHandlerMap := map[string]func(interface{}) error{}
 	loggerConf := &exe.LoggerConf{
 		Enabled:    true,
@@ -35,8 +27,8 @@ func main() {
 		ExeTag:     "ct-bundle",
 		FormatJson: false,
 	}
-	ctxt.ExeContext = exe.InitContext(EXE_USAGE, allowedKindRe, allowedVersionRe,
-		kindImplMap, respHandlerMap, loggerConf)
+	ctxt.ExeContext = exe.InitContext(EXE_USAGE, allowedKindRe, kindImplMap,
+		respHandlerMap, loggerConf)
 	if err := api.ServiceMessages(ctxt.ApiServiceContext); err != nil {
 		exe.Fatal("servicing messages", err, ctxt.ExeContext)
 	}
@@ -1,10 +1,23 @@
 package main
 
-import "alt-os/exe"
+import (
+	"alt-os/exe"
+	"sync"
 
-// HwRuntimeContext holds context information for hw-runtime.
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kind of the service implemented by hw-runtime.
+const SERVICE_KIND = "os.machine.runtime.VmRuntimeService"
+
+// HwRuntimeContext holds context information for hw-runtime. The fields
+// are shared by concurrent requests, so they are only accessed through the
+// methods of the context.
 type HwRuntimeContext struct {
 	*exe.ExeContext
+
+	mu sync.Mutex // Guards the fields below.
 	// Stores the root directory of all image subdirectories on the device.
 	imageDir string
 	// The maximum number of virtual machines to allow at once.
@@ -16,3 +29,59 @@ type HwRuntimeContext struct {
 	// Maps VM ids to their return code channels.
 	vmRetChs map[string]<-chan int
 }
+
+// deployHwEnv creates the environment of hardware deployed from the definition file.
+func (ctxt *HwRuntimeContext) deployHwEnv(id, hwDefFile string) {
+	hwEnv := newHwEnvironment(hwDefFile, ctxt)
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	ctxt.hwEnvs[id] = hwEnv
+}
+
+// startHw runs the environment of deployed hardware that is not yet started.
+// The hardware is reserved as started while its environment starts running,
+// without holding the lock, and can be signaled once it is running.
+func (ctxt *HwRuntimeContext) startHw(id string, signalBufSize int) error {
+	signalCh := make(chan int, signalBufSize)
+	returnCodeCh := make(chan int, 1)
+	ctxt.mu.Lock()
+	hwEnv, ok := ctxt.hwEnvs[id]
+	if !ok {
+		ctxt.mu.Unlock()
+		return status.Errorf(codes.NotFound, id)
+	} else if _, ok := ctxt.vmRetChs[id]; ok {
+		ctxt.mu.Unlock()
+		return status.Errorf(codes.AlreadyExists, id)
+	}
+	ctxt.vmRetChs[id] = returnCodeCh
+	ctxt.mu.Unlock()
+
+	err := hwEnv.Run(signalCh, returnCodeCh)
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if err != nil {
+		delete(ctxt.vmRetChs, id)
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	ctxt.vmSigChs[id] = signalCh
+	return nil
+}
+
+// signalHw sends the signal to started hardware without blocking.
+func (ctxt *HwRuntimeContext) signalHw(id string, signal int) error {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if _, ok := ctxt.hwEnvs[id]; !ok {
+		return status.Errorf(codes.NotFound, id)
+	}
+	vmSigCh, ok := ctxt.vmSigChs[id]
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "%s not started", id)
+	}
+	select {
+	default:
+		return status.Errorf(codes.ResourceExhausted, id)
+	case vmSigCh <- signal:
+	}
+	return nil
+}
@@ -26,7 +26,9 @@ func newHwEnvironment(hwDefFile string, ctxt *HwRuntimeContext) HwEnvironment {
 		exe.Fatal("unmarshaling proto messages", err, ctxt.ExeContext)
 	} else if len(messages) != 1 {
 		fatalReadError("expected exactly 1 message from " + hwDefFile)
-	} else if msg := messages[0]; msg.Kind+"/"+msg.Version != "os.machine.image.VirtualMachine/v0" {
+	} else if msg, err := api.ConvertApiProtoMessage(messages[0], "v0"); err != nil {
+		exe.Fatal("converting hardware definition", err, ctxt.ExeContext)
+	} else if msg.Kind+"/"+msg.Version != "os.machine.image.VirtualMachine/v0" {
 		fatalReadError("got unexpected message kind")
 	} else if hwDef, ok := msg.Def.(*api_os_machine_image_v0.VirtualMachine); !ok {
 		fatalReadError("message type error")
@@ -1,10 +1,11 @@
 package main
 
 import (
+	"alt-os/api"
 	api_os_machine_runtime_v0 "alt-os/api/os/machine/runtime/v0"
 	"alt-os/os/limits"
 	"context"
-	"fmt"
+	"path/filepath"
 
 	"github.com/gogo/protobuf/types"
 	"google.golang.org/grpc/codes"
@@ -26,6 +27,13 @@ type VmRuntimeServiceServerImpl struct {
 func (server *VmRuntimeServiceServerImpl) ApiServe(ctx context.Context,
 	in *api_os_machine_runtime_v0.ApiServeRequest) (*types.Empty, error) {
 
+	if in.ImageDir == "" {
+		return &types.Empty{}, status.Errorf(codes.InvalidArgument, "missing imageDir")
+	}
+	journalFile := filepath.Join(filepath.Clean(in.ImageDir), api.AUDIT_JOURNAL_FILENAME)
+	if err := server.ctxt.OpenAuditJournal(SERVICE_KIND, journalFile); err != nil {
+		return &types.Empty{}, status.Errorf(codes.Internal, "opening audit journal: %v", err)
+	}
 	return &types.Empty{}, nil
 }
 
@@ -33,8 +41,10 @@ func (server *VmRuntimeServiceServerImpl) ApiUnserve(ctx context.Context,
 	in *api_os_machine_runtime_v0.ApiUnserveRequest) (*types.Empty, error) {
 
 	// TODO stop and delete all hardware virtual machines
-	addr := fmt.Sprintf("%s:%d", in.ApiHostname, in.ApiPort)
-	server.ctxt.AddrStopSignalMap[addr]()
+	addr := api.MessageAddr(in)
+	if err := server.ctxt.SignalStop(addr, SERVICE_KIND); err != nil {
+		return &types.Empty{}, status.Errorf(codes.NotFound, err.Error())
+	}
 
 	return &types.Empty{}, nil
 }
@@ -60,20 +70,9 @@ func (server *VmRuntimeServiceServerImpl) Create(ctx context.Context,
 func (server *VmRuntimeServiceServerImpl) Start(ctx context.Context,
 	in *api_os_machine_runtime_v0.StartRequest) (*types.Empty, error) {
 
-	hwEnv, ok := server.ctxt.hwEnvs[in.Id]
-	if !ok {
-		return &types.Empty{}, status.Errorf(codes.NotFound, in.Id)
-	}
-	if _, ok = server.ctxt.vmRetChs[in.Id]; ok {
-		return &types.Empty{}, status.Errorf(codes.AlreadyExists, in.Id)
+	if err := server.ctxt.startHw(in.Id, limits.MAX_PROCESS_SIGNALS); err != nil {
+		return &types.Empty{}, err
 	}
-	signalCh := make(chan int, limits.MAX_PROCESS_SIGNALS)
-	returnCodeCh := make(chan int, 1)
-	if err := hwEnv.Run(signalCh, returnCodeCh); err != nil {
-		return &types.Empty{}, status.Errorf(codes.Internal, err.Error())
-	}
-	server.ctxt.vmSigChs[in.Id] = signalCh
-	server.ctxt.vmRetChs[in.Id] = returnCodeCh
 
 	return &types.Empty{}, nil
 }
@@ -81,19 +80,8 @@ func (server *VmRuntimeServiceServerImpl) Start(ctx context.Context,
 func (server *VmRuntimeServiceServerImpl) Kill(ctx context.Context,
 	in *api_os_machine_runtime_v0.KillRequest) (*types.Empty, error) {
 
-	_, ok := server.ctxt.hwEnvs[in.Id]
-	if !ok {
-		return &types.Empty{}, status.Errorf(codes.NotFound, in.Id)
-	}
-	vmSigCh, ok := server.ctxt.vmSigChs[in.Id]
-	if !ok {
-		return &types.Empty{}, status.Errorf(codes.FailedPrecondition,
-			"%s not started", in.Id)
-	}
-	select {
-	default:
-		return &types.Empty{}, status.Errorf(codes.ResourceExhausted, in.Id)
-	case vmSigCh <- int(in.Signal):
+	if err := server.ctxt.signalHw(in.Id, int(in.Signal)); err != nil {
+		return &types.Empty{}, err
 	}
 
 	return &types.Empty{}, nil
@@ -113,7 +101,6 @@ func (server *VmRuntimeServiceServerImpl) Deploy(ctx context.Context,
 		return &types.Empty{}, status.Errorf(codes.InvalidArgument, "missing hwDefFile")
 	}
 
-	hwEnv := newHwEnvironment(in.HwDefFile, server.ctxt)
-	server.ctxt.hwEnvs[in.Id] = hwEnv
+	server.ctxt.deployHwEnv(in.Id, in.HwDefFile)
 	return &types.Empty{}, nil
 }
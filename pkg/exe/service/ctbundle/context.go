@@ -0,0 +1,21 @@
+package ctbundle
+
+import (
+	"alt-os/exe"
+)
+
+// Kind of the service implemented by the package.
+const SERVICE_KIND = "os.container.bundle.ContainerBundleService"
+
+// CtBundleContext holds context information for ct-bundle.
+type CtBundleContext struct {
+	*exe.ExeContext
+	rootDir string // Stores the root directory of all bundle subdirectories.
+}
+
+// KindImplMap returns the server-impls of the package by service kind/version.
+func KindImplMap(ctxt *CtBundleContext) map[string]interface{} {
+	return map[string]interface{}{
+		SERVICE_KIND + "/v0": NewContainerBundleServiceServerImpl(ctxt),
+	}
+}
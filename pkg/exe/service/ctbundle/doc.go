@@ -0,0 +1,6 @@
+// Copyright © 2022. All rights reserved.
+
+//
+// Container bundle service implementation.
+//
+package ctbundle
@@ -1,11 +1,10 @@
-package main
+package ctbundle
 
 import (
 	"alt-os/api"
 	api_os_container_bundle_v0 "alt-os/api/os/container/bundle/v0"
 	"alt-os/os/container"
 	"context"
-	"fmt"
 	"path/filepath"
 
 	"github.com/gogo/protobuf/types"
@@ -13,8 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// newContainerBundleServiceServerImpl returns a new server-impl for ct-bundle.
-func newContainerBundleServiceServerImpl(ctxt *CtBundleContext) *ContainerBundleServiceServerImpl {
+// NewContainerBundleServiceServerImpl returns a new server-impl for ct-bundle.
+func NewContainerBundleServiceServerImpl(ctxt *CtBundleContext) *ContainerBundleServiceServerImpl {
 	return &ContainerBundleServiceServerImpl{
 		ctxt: ctxt,
 	}
@@ -29,14 +28,20 @@ func (server *ContainerBundleServiceServerImpl) ApiServe(ctx context.Context,
 	in *api_os_container_bundle_v0.ApiServeRequest) (*types.Empty, error) {
 
 	server.ctxt.rootDir = filepath.Clean(in.RootDir)
+	journalFile := filepath.Join(server.ctxt.rootDir, api.AUDIT_JOURNAL_FILENAME)
+	if err := server.ctxt.OpenAuditJournal(SERVICE_KIND, journalFile); err != nil {
+		return &types.Empty{}, status.Errorf(codes.Internal, "opening audit journal: %v", err)
+	}
 	return &types.Empty{}, nil
 }
 
 func (server *ContainerBundleServiceServerImpl) ApiUnserve(ctx context.Context,
 	in *api_os_container_bundle_v0.ApiUnserveRequest) (*types.Empty, error) {
 
-	addr := fmt.Sprintf("%s:%d", in.ApiHostname, in.ApiPort)
-	server.ctxt.AddrStopSignalMap[addr]()
+	addr := api.MessageAddr(in)
+	if err := server.ctxt.SignalStop(addr, SERVICE_KIND); err != nil {
+		return &types.Empty{}, status.Errorf(codes.NotFound, err.Error())
+	}
 
 	return &types.Empty{}, nil
 }
@@ -44,13 +49,9 @@ func (server *ContainerBundleServiceServerImpl) ApiUnserve(ctx context.Context,
 func (server *ContainerBundleServiceServerImpl) Create(ctx context.Context,
 	in *api_os_container_bundle_v0.CreateRequest) (*types.Empty, error) {
 
-	// Load/verify at least one bundle definition to create.
+	// Load/verify at least one bundle definition to create, either set by the
+	// validated request.
 	var bundles []*api_os_container_bundle_v0.Bundle
-	if in.Bundles == nil && in.BundlesFile == "" {
-		return &types.Empty{}, status.Errorf(codes.InvalidArgument, "missing bundle definitions")
-	} else if in.Bundles != nil && in.BundlesFile != "" {
-		return &types.Empty{}, status.Errorf(codes.InvalidArgument, "multiple bundle definition fields")
-	}
 	if in.Bundles != nil {
 		bundles = in.Bundles
 	} else if messages, err := api.UnmarshalApiProtoMessages(in.BundlesFile, ""); err != nil {
@@ -0,0 +1,31 @@
+package ctruntime
+
+import (
+	api_os_container_runtime_v0 "alt-os/api/os/container/runtime/v0"
+	"alt-os/exe"
+)
+
+// Kind of the service implemented by the package.
+const SERVICE_KIND = "os.container.runtime.ContainerRuntimeService"
+
+// CtRuntimeContext holds context information for ct-runtime.
+type CtRuntimeContext struct {
+	*exe.ExeContext
+}
+
+// KindImplMap returns the server-impls of the package by service kind/version.
+func KindImplMap(ctxt *CtRuntimeContext) map[string]interface{} {
+	return map[string]interface{}{
+		SERVICE_KIND + "/v0": NewContainerRuntimeServiceServerImpl(ctxt),
+	}
+}
+
+// RespHandlerMap returns the handlers of responses to client calls of the
+// package's service by service kind/version and method.
+func RespHandlerMap() map[string]func(interface{}) error {
+	return map[string]func(interface{}) error{
+		SERVICE_KIND + "/v0.List": func(resp interface{}) error {
+			return handleRespList(resp.(*api_os_container_runtime_v0.ListResponse))
+		},
+	}
+}
@@ -0,0 +1,6 @@
+// Copyright © 2022. All rights reserved.
+
+//
+// Container runtime service implementation.
+//
+package ctruntime
@@ -1,4 +1,4 @@
-package main
+package ctruntime
 
 import (
 	api_os_container_runtime_v0 "alt-os/api/os/container/runtime/v0"
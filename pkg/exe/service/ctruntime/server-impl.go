@@ -1,15 +1,19 @@
-package main
+package ctruntime
 
 import (
+	"alt-os/api"
 	api_os_container_runtime_v0 "alt-os/api/os/container/runtime/v0"
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// newContainerRuntimeServiceServerImpl returns a new server-impl for ct-runtime.
-func newContainerRuntimeServiceServerImpl(ctxt *CtRuntimeContext) *ContainerRuntimeServiceServerImpl {
+// NewContainerRuntimeServiceServerImpl returns a new server-impl for ct-runtime.
+func NewContainerRuntimeServiceServerImpl(ctxt *CtRuntimeContext) *ContainerRuntimeServiceServerImpl {
 	return &ContainerRuntimeServiceServerImpl{
 		ctxt: ctxt,
 	}
@@ -26,6 +30,14 @@ func (server *ContainerRuntimeServiceServerImpl) ApiServe(ctx context.Context,
 	fmt.Println("serving")
 	// TODO start host
 
+	if in.RootDir == "" {
+		return &types.Empty{}, status.Errorf(codes.InvalidArgument, "missing rootDir")
+	}
+	journalFile := filepath.Join(filepath.Clean(in.RootDir), api.AUDIT_JOURNAL_FILENAME)
+	if err := server.ctxt.OpenAuditJournal(SERVICE_KIND, journalFile); err != nil {
+		return &types.Empty{}, status.Errorf(codes.Internal, "opening audit journal: %v", err)
+	}
+
 	return &types.Empty{}, nil
 }
 
@@ -34,8 +46,10 @@ func (server *ContainerRuntimeServiceServerImpl) ApiUnserve(ctx context.Context,
 
 	fmt.Println("unserving")
 	// TODO stop and delete all containers
-	addr := fmt.Sprintf("%s:%d", in.ApiHostname, in.ApiPort)
-	server.ctxt.AddrStopSignalMap[addr]()
+	addr := api.MessageAddr(in)
+	if err := server.ctxt.SignalStop(addr, SERVICE_KIND); err != nil {
+		return &types.Empty{}, status.Errorf(codes.NotFound, err.Error())
+	}
 
 	return &types.Empty{}, nil
 }
@@ -0,0 +1,21 @@
+package vmimage
+
+import (
+	"alt-os/exe"
+)
+
+// Kind of the service implemented by the package.
+const SERVICE_KIND = "os.machine.image.VmImageService"
+
+// VmImageContext holds context information for vm-image.
+type VmImageContext struct {
+	*exe.ExeContext
+	rootDir string // Stores the root directory of all image subdirectories.
+}
+
+// KindImplMap returns the server-impls of the package by service kind/version.
+func KindImplMap(ctxt *VmImageContext) map[string]interface{} {
+	return map[string]interface{}{
+		SERVICE_KIND + "/v0": NewVmImageServiceServerImpl(ctxt),
+	}
+}
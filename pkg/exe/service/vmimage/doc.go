@@ -0,0 +1,6 @@
+// Copyright © 2022. All rights reserved.
+
+//
+// Virtual machine image service implementation.
+//
+package vmimage
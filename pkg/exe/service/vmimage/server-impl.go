@@ -1,11 +1,10 @@
-package main
+package vmimage
 
 import (
 	"alt-os/api"
 	api_os_machine_image_v0 "alt-os/api/os/machine/image/v0"
 	"alt-os/os/machine"
 	"context"
-	"fmt"
 	"path/filepath"
 
 	"github.com/gogo/protobuf/types"
@@ -13,8 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// newVmImageServiceServerImpl returns a new server-impl for vm-image.
-func newVmImageServiceServerImpl(ctxt *VmImageContext) *VmImageServiceServerImpl {
+// NewVmImageServiceServerImpl returns a new server-impl for vm-image.
+func NewVmImageServiceServerImpl(ctxt *VmImageContext) *VmImageServiceServerImpl {
 	return &VmImageServiceServerImpl{
 		ctxt: ctxt,
 	}
@@ -29,14 +28,20 @@ func (server *VmImageServiceServerImpl) ApiServe(ctx context.Context,
 	in *api_os_machine_image_v0.ApiServeRequest) (*types.Empty, error) {
 
 	server.ctxt.rootDir = filepath.Clean(in.RootDir)
+	journalFile := filepath.Join(server.ctxt.rootDir, api.AUDIT_JOURNAL_FILENAME)
+	if err := server.ctxt.OpenAuditJournal(SERVICE_KIND, journalFile); err != nil {
+		return &types.Empty{}, status.Errorf(codes.Internal, "opening audit journal: %v", err)
+	}
 	return &types.Empty{}, nil
 }
 
 func (server *VmImageServiceServerImpl) ApiUnserve(ctx context.Context,
 	in *api_os_machine_image_v0.ApiUnserveRequest) (*types.Empty, error) {
 
-	addr := fmt.Sprintf("%s:%d", in.ApiHostname, in.ApiPort)
-	server.ctxt.AddrStopSignalMap[addr]()
+	addr := api.MessageAddr(in)
+	if err := server.ctxt.SignalStop(addr, SERVICE_KIND); err != nil {
+		return &types.Empty{}, status.Errorf(codes.NotFound, err.Error())
+	}
 
 	return &types.Empty{}, nil
 }
@@ -44,19 +49,21 @@ func (server *VmImageServiceServerImpl) ApiUnserve(ctx context.Context,
 func (server *VmImageServiceServerImpl) Create(ctx context.Context,
 	in *api_os_machine_image_v0.CreateRequest) (*types.Empty, error) {
 
-	// Load/verify at least one virtual machine definition to create.
+	// Load/verify at least one virtual machine definition to create, either set
+	// by the validated request.
 	var machines []*api_os_machine_image_v0.VirtualMachine
-	if in.VirtualMachines == nil && in.VirtualMachinesFile == "" {
-		return &types.Empty{}, status.Errorf(codes.InvalidArgument, "missing virtual machine definitions")
-	} else if in.VirtualMachines != nil && in.VirtualMachinesFile != "" {
-		return &types.Empty{}, status.Errorf(codes.InvalidArgument, "multiple virtual machine definition fields")
-	}
 	if in.VirtualMachines != nil {
 		machines = in.VirtualMachines
 	} else if messages, err := api.UnmarshalApiProtoMessages(in.VirtualMachinesFile, ""); err != nil {
 		return &types.Empty{}, err
 	} else {
 		for _, msg := range messages {
+			if msg.Kind == "os.machine.image.VirtualMachine" {
+				// Upconvert definitions of older versions.
+				if msg, err = api.ConvertApiProtoMessage(msg, "v0"); err != nil {
+					return &types.Empty{}, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+				}
+			}
 			kindVer := msg.Kind + "/" + msg.Version
 			badTypeErr := status.Errorf(codes.InvalidArgument, "bad virtual machine definition message type")
 			switch kindVer {
@@ -82,7 +89,7 @@ func (server *VmImageServiceServerImpl) Create(ctx context.Context,
 			return &types.Empty{}, status.Errorf(codes.InvalidArgument, "duplicate virtual machine image dir: %s", vm.ImageDir)
 		}
 		if err := machine.ValidateVirtualMachine(vm, true); err != nil {
-			return &types.Empty{}, err
+			return &types.Empty{}, status.Errorf(codes.InvalidArgument, "%s", err.Error())
 		}
 		if err := qemuCreateImage(vm, server.ctxt.rootDir); err != nil {
 			return &types.Empty{}, err
@@ -0,0 +1,135 @@
+package vmruntime
+
+import (
+	"alt-os/exe"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kind of the service implemented by the package.
+const SERVICE_KIND = "os.machine.runtime.VmRuntimeService"
+
+// VmRuntimeContext holds context information for vm-runtime. The fields
+// are shared by concurrent requests, so they are only accessed through the
+// methods of the context.
+type VmRuntimeContext struct {
+	*exe.ExeContext
+
+	mu sync.Mutex // Guards the fields below.
+	// Stores the root directory of all image subdirectories.
+	imageDir string
+	// The maximum number of virtual machines to allow at once.
+	maxMachines int
+	// Maps VM id strings to their environment.
+	vmEnvs map[string]VmEnvironment
+	// Maps VM ids to their kill signal channels.
+	vmSigChs map[string]chan<- int
+	// Maps VM ids to their return code channels, from when they start running.
+	vmRetChs map[string]<-chan int
+	// Returns the environment of a new VM.
+	newVmEnv func(imagePath string, ctxt *VmRuntimeContext) VmEnvironment
+}
+
+// NewVmRuntimeContext returns a new vm-runtime context with no virtual machines.
+func NewVmRuntimeContext() *VmRuntimeContext {
+	return &VmRuntimeContext{
+		vmEnvs:   make(map[string]VmEnvironment),
+		vmSigChs: make(map[string]chan<- int),
+		vmRetChs: make(map[string]<-chan int),
+		newVmEnv: newVmEnvironment,
+	}
+}
+
+// KindImplMap returns the server-impls of the package by service kind/version.
+func KindImplMap(ctxt *VmRuntimeContext) map[string]interface{} {
+	return map[string]interface{}{
+		SERVICE_KIND + "/v0": NewVmRuntimeServiceServerImpl(ctxt),
+	}
+}
+
+// setLimits sets the image directory and maximum number of virtual machines.
+func (ctxt *VmRuntimeContext) setLimits(imageDir string, maxMachines int) {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	ctxt.imageDir = imageDir
+	ctxt.maxMachines = maxMachines
+}
+
+// createVmEnv creates the environment of a new VM for the image, relative to
+// the image directory, unless the maximum number of VMs already exist.
+func (ctxt *VmRuntimeContext) createVmEnv(id, image string) error {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if _, ok := ctxt.vmEnvs[id]; ok {
+		return status.Errorf(codes.AlreadyExists, id)
+	} else if len(ctxt.vmEnvs) >= ctxt.maxMachines {
+		return status.Errorf(codes.ResourceExhausted, "at maxMachines")
+	}
+	imagePath := filepath.Clean(filepath.Join(ctxt.imageDir, image))
+	ctxt.vmEnvs[id] = ctxt.newVmEnv(imagePath, ctxt)
+	return nil
+}
+
+// startVm runs the environment of a created VM that is not yet started. The VM
+// is reserved as started while its environment starts running, without holding
+// the lock, and can be signaled once it is running.
+func (ctxt *VmRuntimeContext) startVm(id string, signalBufSize int) error {
+	signalCh := make(chan int, signalBufSize)
+	returnCodeCh := make(chan int, 1)
+	ctxt.mu.Lock()
+	vmEnv, ok := ctxt.vmEnvs[id]
+	if !ok {
+		ctxt.mu.Unlock()
+		return status.Errorf(codes.NotFound, id)
+	} else if _, ok := ctxt.vmRetChs[id]; ok {
+		ctxt.mu.Unlock()
+		return status.Errorf(codes.AlreadyExists, id)
+	}
+	ctxt.vmRetChs[id] = returnCodeCh
+	ctxt.mu.Unlock()
+
+	err := vmEnv.Run(signalCh, returnCodeCh)
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if err != nil {
+		delete(ctxt.vmRetChs, id)
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	ctxt.vmSigChs[id] = signalCh
+	return nil
+}
+
+// signalVm sends the signal to a started VM without blocking.
+func (ctxt *VmRuntimeContext) signalVm(id string, signal int) error {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	if _, ok := ctxt.vmEnvs[id]; !ok {
+		return status.Errorf(codes.NotFound, id)
+	}
+	vmSigCh, ok := ctxt.vmSigChs[id]
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "%s not started", id)
+	}
+	select {
+	default:
+		return status.Errorf(codes.ResourceExhausted, id)
+	case vmSigCh <- signal:
+	}
+	return nil
+}
+
+// vmIds returns the sorted ids of all created VMs.
+func (ctxt *VmRuntimeContext) vmIds() []string {
+	ctxt.mu.Lock()
+	defer ctxt.mu.Unlock()
+	ids := make([]string, 0, len(ctxt.vmEnvs))
+	for id := range ctxt.vmEnvs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -1,4 +1,4 @@
-package main
+package vmruntime
 
 import (
 	"alt-os/exe"
@@ -1,10 +1,10 @@
-package main
+package vmruntime
 
 import (
+	"alt-os/api"
 	api_os_machine_runtime_v0 "alt-os/api/os/machine/runtime/v0"
 	"alt-os/os/limits"
 	"context"
-	"fmt"
 	"path/filepath"
 
 	"github.com/gogo/protobuf/types"
@@ -12,8 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// newVmRuntimeServiceServerImpl returns a new server-impl for vm-runtime.
-func newVmRuntimeServiceServerImpl(ctxt *VmRuntimeContext) *VmRuntimeServiceServerImpl {
+// NewVmRuntimeServiceServerImpl returns a new server-impl for vm-runtime.
+func NewVmRuntimeServiceServerImpl(ctxt *VmRuntimeContext) *VmRuntimeServiceServerImpl {
 	return &VmRuntimeServiceServerImpl{
 		ctxt: ctxt,
 	}
@@ -27,14 +27,17 @@ type VmRuntimeServiceServerImpl struct {
 func (server *VmRuntimeServiceServerImpl) ApiServe(ctx context.Context,
 	in *api_os_machine_runtime_v0.ApiServeRequest) (*types.Empty, error) {
 
-	server.ctxt.imageDir = filepath.Clean(in.ImageDir)
-	if server.ctxt.imageDir == "" {
+	if in.ImageDir == "" {
 		return &types.Empty{}, status.Errorf(codes.InvalidArgument, "missing imageDir")
 	}
-	server.ctxt.maxMachines = int(in.MaxMachines)
-	if server.ctxt.maxMachines == 0 {
+	if in.MaxMachines == 0 {
 		return &types.Empty{}, status.Errorf(codes.InvalidArgument, "missing maxMachines")
 	}
+	server.ctxt.setLimits(filepath.Clean(in.ImageDir), int(in.MaxMachines))
+	journalFile := filepath.Join(filepath.Clean(in.ImageDir), api.AUDIT_JOURNAL_FILENAME)
+	if err := server.ctxt.OpenAuditJournal(SERVICE_KIND, journalFile); err != nil {
+		return &types.Empty{}, status.Errorf(codes.Internal, "opening audit journal: %v", err)
+	}
 	return &types.Empty{}, nil
 }
 
@@ -42,16 +45,18 @@ func (server *VmRuntimeServiceServerImpl) ApiUnserve(ctx context.Context,
 	in *api_os_machine_runtime_v0.ApiUnserveRequest) (*types.Empty, error) {
 
 	// TODO stop and delete all virtual machines
-	addr := fmt.Sprintf("%s:%d", in.ApiHostname, in.ApiPort)
-	server.ctxt.AddrStopSignalMap[addr]()
+	addr := api.MessageAddr(in)
+	if err := server.ctxt.SignalStop(addr, SERVICE_KIND); err != nil {
+		return &types.Empty{}, status.Errorf(codes.NotFound, err.Error())
+	}
 
 	return &types.Empty{}, nil
 }
 
 func (server *VmRuntimeServiceServerImpl) List(ctx context.Context,
 	in *api_os_machine_runtime_v0.ListRequest) (*api_os_machine_runtime_v0.ListResponse, error) {
-	// TODO
-	return &api_os_machine_runtime_v0.ListResponse{}, nil
+
+	return &api_os_machine_runtime_v0.ListResponse{Id: server.ctxt.vmIds()}, nil
 }
 
 func (server *VmRuntimeServiceServerImpl) QueryState(ctx context.Context,
@@ -63,12 +68,9 @@ func (server *VmRuntimeServiceServerImpl) QueryState(ctx context.Context,
 func (server *VmRuntimeServiceServerImpl) Create(ctx context.Context,
 	in *api_os_machine_runtime_v0.CreateRequest) (*types.Empty, error) {
 
-	if len(server.ctxt.vmEnvs) >= server.ctxt.maxMachines {
-		return &types.Empty{}, status.Errorf(codes.ResourceExhausted, "at maxMachines")
+	if err := server.ctxt.createVmEnv(in.Id, in.Image); err != nil {
+		return &types.Empty{}, err
 	}
-	imagePath := filepath.Clean(filepath.Join(server.ctxt.imageDir, in.Image))
-	vmEnv := newVmEnvironment(imagePath, server.ctxt)
-	server.ctxt.vmEnvs[in.Id] = vmEnv
 
 	return &types.Empty{}, nil
 }
@@ -76,20 +78,9 @@ func (server *VmRuntimeServiceServerImpl) Create(ctx context.Context,
 func (server *VmRuntimeServiceServerImpl) Start(ctx context.Context,
 	in *api_os_machine_runtime_v0.StartRequest) (*types.Empty, error) {
 
-	vmEnv, ok := server.ctxt.vmEnvs[in.Id]
-	if !ok {
-		return &types.Empty{}, status.Errorf(codes.NotFound, in.Id)
+	if err := server.ctxt.startVm(in.Id, limits.MAX_PROCESS_SIGNALS); err != nil {
+		return &types.Empty{}, err
 	}
-	if _, ok = server.ctxt.vmRetChs[in.Id]; ok {
-		return &types.Empty{}, status.Errorf(codes.AlreadyExists, in.Id)
-	}
-	signalCh := make(chan int, limits.MAX_PROCESS_SIGNALS)
-	returnCodeCh := make(chan int, 1)
-	if err := vmEnv.Run(signalCh, returnCodeCh); err != nil {
-		return &types.Empty{}, status.Errorf(codes.Internal, err.Error())
-	}
-	server.ctxt.vmSigChs[in.Id] = signalCh
-	server.ctxt.vmRetChs[in.Id] = returnCodeCh
 
 	return &types.Empty{}, nil
 }
@@ -97,19 +88,8 @@ func (server *VmRuntimeServiceServerImpl) Start(ctx context.Context,
 func (server *VmRuntimeServiceServerImpl) Kill(ctx context.Context,
 	in *api_os_machine_runtime_v0.KillRequest) (*types.Empty, error) {
 
-	_, ok := server.ctxt.vmEnvs[in.Id]
-	if !ok {
-		return &types.Empty{}, status.Errorf(codes.NotFound, in.Id)
-	}
-	vmSigCh, ok := server.ctxt.vmSigChs[in.Id]
-	if !ok {
-		return &types.Empty{}, status.Errorf(codes.FailedPrecondition,
-			"%s not started", in.Id)
-	}
-	select {
-	default:
-		return &types.Empty{}, status.Errorf(codes.ResourceExhausted, in.Id)
-	case vmSigCh <- int(in.Signal):
+	if err := server.ctxt.signalVm(in.Id, int(in.Signal)); err != nil {
+		return &types.Empty{}, err
 	}
 
 	return &types.Empty{}, nil
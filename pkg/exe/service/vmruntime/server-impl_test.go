@@ -0,0 +1,96 @@
+package vmruntime
+
+import (
+	api_os_machine_runtime_v0 "alt-os/api/os/machine/runtime/v0"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeVmEnv is a VmEnvironment that runs until it is killed, and takes a while
+// to start so that calls overlap the start.
+type fakeVmEnv struct{}
+
+func (fakeVmEnv) Run(signalCh <-chan int, returnCodeCh chan<- int) error {
+	time.Sleep(time.Millisecond)
+	go func() {
+		<-signalCh
+		returnCodeCh <- 0
+	}()
+	return nil
+}
+
+func TestServerImplConcurrentCalls(t *testing.T) {
+	const vms = 8
+	ctxt := NewVmRuntimeContext()
+	ctxt.newVmEnv = func(string, *VmRuntimeContext) VmEnvironment { return fakeVmEnv{} }
+	ctxt.setLimits(t.TempDir(), vms)
+	server := NewVmRuntimeServiceServerImpl(ctxt)
+	ctx := context.Background()
+
+	// Each call is made by several goroutines, and only one of each Create
+	// and Start call of a VM succeeds.
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	codeCounts := make(map[string]int)
+	call := func(method string, f func() error) {
+		defer wg.Done()
+		code := status.Code(f())
+		mu.Lock()
+		defer mu.Unlock()
+		codeCounts[method+" "+code.String()]++
+	}
+	for i := 0; i < vms; i++ {
+		id := fmt.Sprintf("vm%d", i)
+		for j := 0; j < 3; j++ {
+			wg.Add(4)
+			go call("Create", func() error {
+				_, err := server.Create(ctx, &api_os_machine_runtime_v0.CreateRequest{Id: id, Image: id})
+				return err
+			})
+			go call("Start", func() error {
+				for {
+					_, err := server.Start(ctx, &api_os_machine_runtime_v0.StartRequest{Id: id})
+					if status.Code(err) != codes.NotFound {
+						return err
+					}
+					time.Sleep(time.Millisecond)
+				}
+			})
+			go call("Kill", func() error {
+				_, err := server.Kill(ctx, &api_os_machine_runtime_v0.KillRequest{Id: id, Signal: 9})
+				return err
+			})
+			go call("List", func() error {
+				_, err := server.List(ctx, &api_os_machine_runtime_v0.ListRequest{})
+				return err
+			})
+		}
+	}
+	wg.Wait()
+
+	for _, expected := range []string{"Create OK", "Start OK"} {
+		if codeCounts[expected] != vms {
+			t.Errorf("%s: got %d calls, expected %d", expected, codeCounts[expected], vms)
+		}
+	}
+	if codeCounts["List OK"] != 3*vms {
+		t.Errorf("List OK: got %d calls, expected %d", codeCounts["List OK"], 3*vms)
+	}
+	if resp, err := server.List(ctx, &api_os_machine_runtime_v0.ListRequest{}); err != nil {
+		t.Fatal(err)
+	} else if len(resp.Id) != vms {
+		t.Errorf("got %d vms, expected %d", len(resp.Id), vms)
+	}
+	for i := 0; i < vms; i++ {
+		id := fmt.Sprintf("vm%d", i)
+		if _, err := server.Kill(ctx, &api_os_machine_runtime_v0.KillRequest{Id: id, Signal: 9}); err != nil {
+			t.Errorf("killing %s: %v", id, err)
+		}
+	}
+}
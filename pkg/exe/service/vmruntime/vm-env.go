@@ -1,4 +1,4 @@
-package main
+package vmruntime
 
 import (
 	api_os_machine_image_v0 "alt-os/api/os/machine/image/v0"
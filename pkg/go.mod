@@ -8,6 +8,7 @@ replace oci => ../third-party/oci
 require (
 	oci v0.0.0
 	github.com/gogo/protobuf v1.3.2
+	github.com/golang/protobuf v1.4.3
 	github.com/google/uuid v1.3.0
 	google.golang.org/grpc v1.43.0
 	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b
@@ -46,7 +46,9 @@ func CreateBundle(def *api_os_container_bundle_v0.Bundle, rootDir string) error
 			return err
 		} else if len(messages) != 1 {
 			return makeError("expected exactly 1 message from " + def.VirtualMachineFile)
-		} else if msg := messages[0]; msg.Kind+"/"+msg.Version != "os.machine.image.VirtualMachine/v0" {
+		} else if msg, err := api.ConvertApiProtoMessage(messages[0], "v0"); err != nil {
+			return err
+		} else if msg.Kind+"/"+msg.Version != "os.machine.image.VirtualMachine/v0" {
 			return makeError("got unexpected message kind")
 		} else if typedDef, ok := msg.Def.(*api_os_machine_image_v0.VirtualMachine); !ok {
 			return makeError("message type error")
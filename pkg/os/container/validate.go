@@ -3,68 +3,56 @@ package container
 import (
 	api_os_container_bundle_v0 "alt-os/api/os/container/bundle/v0"
 	api_os_container_process_v0 "alt-os/api/os/container/process/v0"
+	api_validate_v0 "alt-os/api/validate/v0"
 	"alt-os/os/machine"
 	"errors"
+	"strconv"
 )
 
 // ValidateBundle verifies that all values of the Bundle are valid
-// according to OCI specifications. Process is required under the assumption
-// that ContainerRuntimeService.Start will be called on the created bundle.
+// according to OCI specifications, returning all violations of the validation
+// rules of its message, of ValidateProcess and of machine.ValidateVirtualized.
+// Process is required under the assumption that ContainerRuntimeService.Start
+// will be called on the created bundle.
 func ValidateBundle(def *api_os_container_bundle_v0.Bundle) error {
-	makeError := func(msg string) error {
-		return errors.New(msg + " for validating os.container.bundle.Bundle")
-	}
 	if def == nil {
-		return makeError("missing Bundle")
-	}
-	for _, vol := range def.VolumeMounts {
-		if vol.Destination == "" {
-			return makeError("missing definition for `volumeMounts.destination`")
-		}
+		return errors.New("missing Bundle for validating os.container.bundle.Bundle")
 	}
-	if def.Process == nil {
-		return makeError("missing definition for `process`")
+	var violations api_validate_v0.Violations
+	if err := def.Validate(); err != nil && !errors.As(err, &violations) {
+		return err
 	}
-	if def.Process.Terminal != nil && def.Process.Terminal.Enable {
-		if def.Process.Terminal.Height == 0 || def.Process.Terminal.Width == 0 {
-			return makeError("bad terminal size")
-		}
-	}
-	if def.Process.Cwd == "" {
-		return makeError("missing definition for `process.cwd`")
+	violations.AddNested("process", ValidateProcess(def.Process))
+	violations.AddNested("virtualMachine", machine.ValidateVirtualized(def.VirtualMachine))
+	return violations.Err()
+}
+
+// ValidateProcess verifies the values of the ContainerProcess that the
+// validation rules of its message cannot declare: the terminal size and
+// resource limits.
+func ValidateProcess(def *api_os_container_process_v0.ContainerProcess) error {
+	var violations api_validate_v0.Violations
+	if def == nil {
+		return nil
 	}
-	for _, envVar := range def.Process.Env {
-		if envVar.Name == "" {
-			return makeError("missing name for `process.env` var")
+	if def.Terminal != nil && def.Terminal.Enable {
+		if def.Terminal.Height == 0 || def.Terminal.Width == 0 {
+			violations.Add("terminal", "bad terminal size")
 		}
 	}
 	sawRlimit := map[string]struct{}{}
-	for _, rlimit := range def.Process.Rlimits {
-		if rlimit.Type == api_os_container_process_v0.ResourceLimitType_RLIMIT_NONE {
-			return makeError("null `process.rlimits.type`")
-		}
+	for i, rlimit := range def.Rlimits {
+		field := "rlimits[" + strconv.Itoa(i) + "]"
 		if !rlimit.HardUnlimited && rlimit.HardValue == _RLIMIT_UNLIMITED_VALUE {
-			return makeError("bad `process.rlimits.hardValue`")
+			violations.Add(field+".hardValue", "bad value without hardUnlimited")
 		}
 		if !rlimit.SoftUnlimited && rlimit.SoftValue == _RLIMIT_UNLIMITED_VALUE {
-			return makeError("bad `process.rlimits.softValue`")
+			violations.Add(field+".softValue", "bad value without softUnlimited")
 		}
 		if _, ok := sawRlimit[rlimit.Type.String()]; ok {
-			return makeError("duplicate `process.rlimits.type` " + rlimit.Type.String())
+			violations.Add(field+".type", "duplicate "+rlimit.Type.String())
 		}
 		sawRlimit[rlimit.Type.String()] = struct{}{}
 	}
-	if def.Process.User == nil {
-		return makeError("missing definition for `process.user`")
-	}
-	if def.VirtualMachine != nil {
-		if def.VirtualMachineFile != "" {
-			return errors.New("multiple virtual machine definition fields")
-		}
-		if err := machine.ValidateVirtualMachine(def.VirtualMachine, true); err != nil {
-			return nil
-		}
-	}
-
-	return nil
+	return violations.Err()
 }
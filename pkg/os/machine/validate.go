@@ -2,42 +2,43 @@ package machine
 
 import (
 	api_os_machine_image_v0 "alt-os/api/os/machine/image/v0"
+	api_validate_v0 "alt-os/api/validate/v0"
 	"errors"
 )
 
 // ValidateVirtualMachine verifies that all values of the VirtualMachine
-// are valid.
+// are valid, returning all violations of the validation rules of its message,
+// and of ValidateVirtualized if virtualized.
 func ValidateVirtualMachine(def *api_os_machine_image_v0.VirtualMachine, virtualized bool) error {
-	makeError := func(msg string) error {
-		return errors.New(msg + " for validating os.machine.image.VirtualMachine")
-	}
 	if def == nil {
-		return makeError("missing VirtualMachine")
+		return errors.New("missing VirtualMachine for validating os.machine.image.VirtualMachine")
 	}
-	if def.ImageDir == "" {
-		return makeError("bad `VirtualMachine.imageDir`")
+	var violations api_validate_v0.Violations
+	if err := def.Validate(); err != nil && !errors.As(err, &violations) {
+		return err
 	}
-	if def.Processors == 0 {
-		return makeError("bad `VirtualMachine.processors`")
+	if virtualized {
+		violations.AddNested("", ValidateVirtualized(def))
 	}
-	if def.Memory == 0 {
-		return makeError("bad `VirtualMachine.memory`")
+	return violations.Err()
+}
+
+// ValidateVirtualized verifies that the VirtualMachine has the values a
+// virtualized machine requires beyond the validation rules of its message,
+// which are the images to boot with.
+func ValidateVirtualized(def *api_os_machine_image_v0.VirtualMachine) error {
+	var violations api_validate_v0.Violations
+	if def == nil {
+		return nil
 	}
-	for _, serial := range def.Serial {
-		if serial.Address != 0 && serial.Port != 0 {
-			return makeError("bad `VirtualMachine.serial`: cannot have both port and address")
-		}
+	if def.EfiPath == "" {
+		violations.Add("efiPath", "required for a virtualized machine")
 	}
-	if virtualized {
-		if def.EfiPath == "" {
-			return makeError("missing `VirtualMachine.efiPath`")
-		}
-		if def.BiosImage == "" {
-			return makeError("missing `VirtualMachine.biosImage`")
-		}
-		if def.VarsImage == "" {
-			return makeError("missing `VirtualMachine.varsImage`")
-		}
+	if def.BiosImage == "" {
+		violations.Add("biosImage", "required for a virtualized machine")
+	}
+	if def.VarsImage == "" {
+		violations.Add("varsImage", "required for a virtualized machine")
 	}
-	return nil
+	return violations.Err()
 }
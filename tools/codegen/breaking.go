@@ -0,0 +1,281 @@
+package main
+
+import (
+	"alt-os/exe"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// Descriptor set of the api as last generated, relative to the source root.
+const _PROTO_BASELINE_FILE = "pkg/api/zbaseline.pb"
+
+// protoCheckBreaking compares the descriptors of the api with the baseline and
+// prints each change that breaks the wire or json format of existing messages
+// or existing clients. Exits with error if there are any, unless allowed.
+func protoCheckBreaking(current *descriptor.FileDescriptorSet, ctxt *CodegenContext) {
+	baselineFilename := filepath.Join(ctxt.SrcRootDir, filepath.FromSlash(_PROTO_BASELINE_FILE))
+	baseline := &descriptor.FileDescriptorSet{}
+	if data, err := os.ReadFile(baselineFilename); errors.Is(err, os.ErrNotExist) {
+		return
+	} else if err != nil {
+		exe.Fatal("reading baseline", err, ctxt.ExeContext)
+	} else if err := proto.Unmarshal(data, baseline); err != nil {
+		exe.Fatal("unmarshaling baseline", err, ctxt.ExeContext)
+	}
+	changes := protoBreakingChanges(baseline, current)
+	for _, change := range changes {
+		fmt.Fprintln(os.Stderr, "breaking change: "+change)
+	}
+	if len(changes) > 0 && !ctxt.AllowBreaking {
+		exe.Fatal("checking breaking changes", fmt.Errorf(
+			"%d breaking change(s) found, run with -allow-breaking to generate anyway", len(changes)), ctxt.ExeContext)
+	}
+}
+
+// protoSaveBaseline writes the descriptors of the api as the baseline that the
+// next generation is compared with.
+func protoSaveBaseline(current *descriptor.FileDescriptorSet, ctxt *CodegenContext) {
+	baselineFilename := filepath.Join(ctxt.SrcRootDir, filepath.FromSlash(_PROTO_BASELINE_FILE))
+	if data, err := proto.Marshal(current); err != nil {
+		exe.Fatal("marshaling baseline", err, ctxt.ExeContext)
+	} else if err := os.WriteFile(baselineFilename, data, 0644); err != nil {
+		exe.Fatal("writing "+baselineFilename, err, ctxt.ExeContext)
+	}
+}
+
+// Stores the definitions of a descriptor set by versioned name, e.g.
+// os.machine.image.v0.VirtualMachine.
+type protoDefinitions struct {
+	Messages map[string]*descriptor.DescriptorProto
+	Enums    map[string]*descriptor.EnumDescriptorProto
+	Services map[string]*descriptor.ServiceDescriptorProto
+	Names    []string // Names of all definitions in order of declaration.
+}
+
+// protoBreakingChanges returns descriptions of the changes from the baseline to
+// the current descriptors that break existing messages or clients: removed
+// messages, fields, enums, enum values, services and methods, and changed
+// field numbers, field types, json names, enum value numbers and method types.
+func protoBreakingChanges(baseline, current *descriptor.FileDescriptorSet) []string {
+	oldDefs, newDefs := protoCollectDefinitions(baseline), protoCollectDefinitions(current)
+	var changes []string
+	for _, name := range oldDefs.Names {
+		if oldMsg, ok := oldDefs.Messages[name]; ok {
+			if newMsg, ok := newDefs.Messages[name]; !ok {
+				changes = append(changes, "message "+name+" removed")
+			} else {
+				changes = append(changes, protoFieldChanges(name, oldMsg, newMsg)...)
+			}
+		} else if oldEnum, ok := oldDefs.Enums[name]; ok {
+			if newEnum, ok := newDefs.Enums[name]; !ok {
+				changes = append(changes, "enum "+name+" removed")
+			} else {
+				changes = append(changes, protoEnumValueChanges(name, oldEnum, newEnum)...)
+			}
+		} else if oldService, ok := oldDefs.Services[name]; ok {
+			if newService, ok := newDefs.Services[name]; !ok {
+				changes = append(changes, "service "+name+" removed")
+			} else {
+				changes = append(changes, protoMethodChanges(name, oldService, newService)...)
+			}
+		}
+	}
+	return changes
+}
+
+// protoCollectDefinitions returns the messages, enums and services of the
+// descriptor set, including nested ones.
+func protoCollectDefinitions(set *descriptor.FileDescriptorSet) *protoDefinitions {
+	defs := &protoDefinitions{
+		Messages: make(map[string]*descriptor.DescriptorProto),
+		Enums:    make(map[string]*descriptor.EnumDescriptorProto),
+		Services: make(map[string]*descriptor.ServiceDescriptorProto),
+	}
+	var addMessage func(prefix string, msg *descriptor.DescriptorProto)
+	addEnum := func(prefix string, enum *descriptor.EnumDescriptorProto) {
+		name := prefix + enum.GetName()
+		defs.Enums[name] = enum
+		defs.Names = append(defs.Names, name)
+	}
+	addMessage = func(prefix string, msg *descriptor.DescriptorProto) {
+		name := prefix + msg.GetName()
+		defs.Messages[name] = msg
+		defs.Names = append(defs.Names, name)
+		for _, nested := range msg.NestedType {
+			addMessage(name+".", nested)
+		}
+		for _, enum := range msg.EnumType {
+			addEnum(name+".", enum)
+		}
+	}
+	for _, f := range set.File {
+		// Versions of a package share its name, so names include the version.
+		prefix := f.GetPackage() + "." + path.Base(path.Dir(f.GetName())) + "."
+		for _, msg := range f.MessageType {
+			addMessage(prefix, msg)
+		}
+		for _, enum := range f.EnumType {
+			addEnum(prefix, enum)
+		}
+		for _, service := range f.Service {
+			name := prefix + service.GetName()
+			defs.Services[name] = service
+			defs.Names = append(defs.Names, name)
+		}
+	}
+	return defs
+}
+
+// protoFieldChanges returns the breaking changes to the fields of a message.
+// Fields are matched by number, or by name if renumbered.
+func protoFieldChanges(msgName string, oldMsg, newMsg *descriptor.DescriptorProto) []string {
+	newByNumber := make(map[int32]*descriptor.FieldDescriptorProto)
+	newByName := make(map[string]*descriptor.FieldDescriptorProto)
+	for _, field := range newMsg.Field {
+		newByNumber[field.GetNumber()] = field
+		newByName[field.GetName()] = field
+	}
+	var changes []string
+	for _, oldField := range oldMsg.Field {
+		fieldName := msgName + "." + oldField.GetName()
+		newField, ok := newByNumber[oldField.GetNumber()]
+		if !ok {
+			if renumbered, ok := newByName[oldField.GetName()]; ok {
+				changes = append(changes, fmt.Sprintf("field %s number changed from %d to %d",
+					fieldName, oldField.GetNumber(), renumbered.GetNumber()))
+			} else {
+				changes = append(changes, "field "+fieldName+" removed")
+			}
+			continue
+		}
+		if oldType, newType := protoFieldTypeName(oldField), protoFieldTypeName(newField); oldType != newType {
+			changes = append(changes, "field "+fieldName+" type changed from "+oldType+" to "+newType)
+		}
+		if oldJson, newJson := protoFieldJsonName(oldField), protoFieldJsonName(newField); oldJson != newJson {
+			changes = append(changes, "field "+fieldName+" json name changed from "+oldJson+" to "+newJson)
+		}
+	}
+	return changes
+}
+
+// protoEnumValueChanges returns the breaking changes to the values of an enum,
+// which are matched by name as json names them.
+func protoEnumValueChanges(enumName string, oldEnum, newEnum *descriptor.EnumDescriptorProto) []string {
+	newByName := make(map[string]*descriptor.EnumValueDescriptorProto)
+	for _, value := range newEnum.Value {
+		newByName[value.GetName()] = value
+	}
+	var changes []string
+	for _, oldValue := range oldEnum.Value {
+		valueName := enumName + "." + oldValue.GetName()
+		if newValue, ok := newByName[oldValue.GetName()]; !ok {
+			changes = append(changes, "enum value "+valueName+" removed")
+		} else if newValue.GetNumber() != oldValue.GetNumber() {
+			changes = append(changes, fmt.Sprintf("enum value %s number changed from %d to %d",
+				valueName, oldValue.GetNumber(), newValue.GetNumber()))
+		}
+	}
+	return changes
+}
+
+// protoMethodChanges returns the breaking changes to the methods of a service.
+func protoMethodChanges(serviceName string, oldService, newService *descriptor.ServiceDescriptorProto) []string {
+	newByName := make(map[string]*descriptor.MethodDescriptorProto)
+	for _, method := range newService.Method {
+		newByName[method.GetName()] = method
+	}
+	var changes []string
+	for _, oldMethod := range oldService.Method {
+		methodName := serviceName + "." + oldMethod.GetName()
+		newMethod, ok := newByName[oldMethod.GetName()]
+		if !ok {
+			changes = append(changes, "method "+methodName+" removed")
+			continue
+		}
+		if oldType, newType := protoMethodTypeName(oldMethod), protoMethodTypeName(newMethod); oldType != newType {
+			changes = append(changes, "method "+methodName+" type changed from "+oldType+" to "+newType)
+		}
+	}
+	return changes
+}
+
+// protoFieldTypeName returns the type of the field as declared, e.g. repeated
+// int32 or os.machine.image.VirtualMachine.
+func protoFieldTypeName(field *descriptor.FieldDescriptorProto) string {
+	typeName := strings.TrimPrefix(field.GetTypeName(), ".")
+	if typeName == "" {
+		typeName = strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+	}
+	if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		typeName = "repeated " + typeName
+	}
+	return typeName
+}
+
+// protoFieldJsonName returns the name of the field in json.
+func protoFieldJsonName(field *descriptor.FieldDescriptorProto) string {
+	if field.JsonName != nil {
+		return field.GetJsonName()
+	}
+	return protoJsonName(field.GetName())
+}
+
+// protoMethodTypeName returns the signature of the method, e.g.
+// (os.machine.runtime.ListRequest) returns (stream os.machine.runtime.ListResponse).
+func protoMethodTypeName(method *descriptor.MethodDescriptorProto) string {
+	typeName := func(name string, streaming bool) string {
+		name = strings.TrimPrefix(name, ".")
+		if streaming {
+			name = "stream " + name
+		}
+		return name
+	}
+	return "(" + typeName(method.GetInputType(), method.GetClientStreaming()) + ") returns (" +
+		typeName(method.GetOutputType(), method.GetServerStreaming()) + ")"
+}
+
+// protoWireCompatible returns whether the fields of the message type of the
+// older file are unchanged in the newer file, as are the fields and values of
+// the messages and enums of the file that its fields use, so that messages of
+// the type convert between the versions by wire format.
+func protoWireCompatible(typeName string, oldFile, newFile *descriptor.FileDescriptorProto) bool {
+	oldDefs := protoCollectDefinitions(&descriptor.FileDescriptorSet{File: []*descriptor.FileDescriptorProto{oldFile}})
+	newDefs := protoCollectDefinitions(&descriptor.FileDescriptorSet{File: []*descriptor.FileDescriptorProto{newFile}})
+	oldPrefix := oldFile.GetPackage() + "." + path.Base(path.Dir(oldFile.GetName())) + "."
+	newPrefix := newFile.GetPackage() + "." + path.Base(path.Dir(newFile.GetName())) + "."
+	pkgPrefix := "." + oldFile.GetPackage() + "."
+	checked := make(map[string]bool)
+	var compatible func(name string) bool
+	compatible = func(name string) bool {
+		if checked[name] {
+			return true
+		}
+		checked[name] = true
+		if oldMsg, ok := oldDefs.Messages[oldPrefix+name]; ok {
+			newMsg, ok := newDefs.Messages[newPrefix+name]
+			if !ok || len(protoFieldChanges(name, oldMsg, newMsg)) > 0 {
+				return false
+			}
+			for _, field := range oldMsg.Field {
+				if strings.HasPrefix(field.GetTypeName(), pkgPrefix) &&
+					!compatible(strings.TrimPrefix(field.GetTypeName(), pkgPrefix)) {
+					return false
+				}
+			}
+		} else if oldEnum, ok := oldDefs.Enums[oldPrefix+name]; ok {
+			newEnum, ok := newDefs.Enums[newPrefix+name]
+			if !ok || len(protoEnumValueChanges(name, oldEnum, newEnum)) > 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return compatible(typeName)
+}
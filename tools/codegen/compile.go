@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"go/format"
+	"os"
+
+	_ "github.com/gogo/protobuf/plugin/compare"
+	_ "github.com/gogo/protobuf/plugin/defaultcheck"
+	_ "github.com/gogo/protobuf/plugin/description"
+	_ "github.com/gogo/protobuf/plugin/embedcheck"
+	_ "github.com/gogo/protobuf/plugin/enumstringer"
+	_ "github.com/gogo/protobuf/plugin/equal"
+	_ "github.com/gogo/protobuf/plugin/face"
+	_ "github.com/gogo/protobuf/plugin/gostring"
+	_ "github.com/gogo/protobuf/plugin/marshalto"
+	_ "github.com/gogo/protobuf/plugin/oneofcheck"
+	_ "github.com/gogo/protobuf/plugin/populate"
+	_ "github.com/gogo/protobuf/plugin/size"
+	_ "github.com/gogo/protobuf/plugin/stringer"
+	_ "github.com/gogo/protobuf/plugin/union"
+	_ "github.com/gogo/protobuf/plugin/unmarshal"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	_ "github.com/gogo/protobuf/protoc-gen-gogo/grpc"
+	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	golang_proto "github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"golang.org/x/tools/imports"
+)
+
+// protoGogoFileDescriptors returns the gogo descriptors of the parsed file and
+// of all the files it imports, with each file after the files it imports, as
+// protoc passes them to its plugins. The parsed file is last.
+func protoGogoFileDescriptors(fileDesc *desc.FileDescriptor) ([]*descriptor.FileDescriptorProto, error) {
+	var files []*descriptor.FileDescriptorProto
+	added := make(map[string]bool)
+	var add func(fileDesc *desc.FileDescriptor) error
+	add = func(fileDesc *desc.FileDescriptor) error {
+		if added[fileDesc.GetName()] {
+			return nil
+		}
+		added[fileDesc.GetName()] = true
+		for _, dep := range fileDesc.GetDependencies() {
+			if err := add(dep); err != nil {
+				return err
+			}
+		}
+		// Convert by wire format, which keeps the options of extensions unknown
+		// to either library.
+		file := &descriptor.FileDescriptorProto{}
+		if data, err := golang_proto.Marshal(fileDesc.AsFileDescriptorProto()); err != nil {
+			return err
+		} else if err := proto.Unmarshal(data, file); err != nil {
+			return err
+		}
+		files = append(files, file)
+		return nil
+	}
+	if err := add(fileDesc); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// protoGenerateGogo runs the gogo generator with its plugins on the request as
+// protoc-gen-gogo does, without its test plugin, and returns the formatted go
+// files generated. The plugins are shared, so calls must not be concurrent.
+func protoGenerateGogo(req *plugin.CodeGeneratorRequest) ([]*plugin.CodeGeneratorResponse_File, error) {
+	g := generator.New()
+	g.Request = req
+	g.CommandLineParameters(req.GetParameter())
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+	if g.Response.Error != nil {
+		return nil, errors.New(g.Response.GetError())
+	}
+	for _, file := range g.Response.File {
+		formatted, err := format.Source([]byte(file.GetContent()))
+		if err != nil {
+			return nil, err
+		}
+		file.Content = proto.String(string(formatted))
+	}
+	return g.Response.File, nil
+}
+
+// protoFormatGoFile formats the generated go file and adds or removes its
+// imports as goimports does.
+func protoFormatGoFile(filename string) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	formatted, err := imports.Process(filename, src, nil)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, formatted, 0644)
+}
@@ -18,19 +18,21 @@ generation on the source tree.
 // CodegenContext holds context information for codegen.
 type CodegenContext struct {
 	*exe.ExeContext
-	SrcRootDir string
+	SrcRootDir    string
+	AllowBreaking bool // Overwrite generated code despite breaking api changes.
 }
 
 // main is the entry point.
 func main() {
 	// Parse command line.
-	var pb bool
+	var pb, allowBreaking bool
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "%s\n", EXE_USAGE)
 		fmt.Fprintf(os.Stderr, "Usage:\n")
 		flag.PrintDefaults()
 	}
 	flag.BoolVar(&pb, "p", false, "Generate the protocol buffer code")
+	flag.BoolVar(&allowBreaking, "allow-breaking", false, "Generate the protocol buffer code despite breaking changes from the baseline")
 	flag.Parse()
 
 	if !pb {
@@ -40,6 +42,7 @@ func main() {
 
 	// Initialize the context and get all module dependencies.
 	ctxt := initContext()
+	ctxt.AllowBreaking = allowBreaking
 	if stdOut, stdErr, err := exe.Doexec("", "go", "mod", "download", "all"); err != nil {
 		exe.Fatal("getting modules", exe.ErrOutput(stdOut, stdErr, err), ctxt.ExeContext)
 	}
@@ -1,24 +1,34 @@
 package main
 
 import (
+	api_validate_v0 "alt-os/api/validate/v0"
 	"alt-os/exe"
 	"fmt"
 	"io/fs"
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
 	_ "github.com/gogo/googleapis/google/api"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	"github.com/jhump/protoreflect/desc/protoparse"
 	_ "google.golang.org/grpc"
 )
 
 // Maximum number of protobuf packages to be able to process.
 const _MAX_PROTO_PACKAGES = 1000
 
+// Matches the names of version directories of the api source tree.
+var protoVersionRe = regexp.MustCompile(`^v[0-9]+`)
+
 // Autogenerated code template for imports.
 const _PROTO_IMPORT_AUTOGEN = `// Code generated by codegen. DO NOT EDIT.
 package api
@@ -66,76 +76,82 @@ const _PROTO_MARSHAL_AUTOGEN_2 = `	}
 }
 `
 
+// Autogenerated code template: zconverting.go.
+const _PROTO_CONVERT_AUTOGEN_0 = `)
+// kindVersions lists the known versions of each message kind, oldest first.
+var kindVersions = map[string][]string{
+`
+
+// Autogenerated code template: zconverting.go.
+const _PROTO_CONVERT_AUTOGEN_1 = `}
+// init registers the default converters between consecutive versions of each
+// kind whose fields are unchanged.
+func init() {
+`
+
+// Autogenerated code template: zconverting.go.
+const _PROTO_CONVERT_AUTOGEN_2 = `}
+`
+
 // Autogenerated code template: zservicing.go.
 const _PROTO_SERVICE_AUTOGEN_0 = `)
-// serviceMessageKinds processes each specific message kind.
-func serviceMessageKinds(ctxt *ApiServiceContext) error {
-	for _, apiMsg := range ctxt.MessageQueue {
-		switch msg := apiMsg.Def.(type) {
-		default:
-			return errors.New("invalid message kind: " + apiMsg.Kind)
+// serviceMessageKind processes a single message of a specific kind and returns
+// the response to it.
+func serviceMessageKind(apiMsg *ApiProtoMessage, ctxt *ApiServiceContext) (proto.Message, error) {
+	switch msg := apiMsg.Def.(type) {
+	default:
+		return nil, errors.New("invalid message kind: " + apiMsg.Kind)
 `
 
 // Autogenerated code template: zservicing.go.
 const _PROTO_SERVICE_AUTOGEN_1 = `}
 }
-for addr := range ctxt.AddrServerMap {
-	if err := ctxt.AddrStopWaitMap[addr](); err != nil {
-		return nil
-	}
-}
-ctxt.ServerWg.Wait()
-return nil
-}
-// makeClientKind makes a new specific grpc client kind in the context.
-func makeClientKind(addr string, ctxt *ApiServiceContext) error {
-switch ctxt.AddrKindVerMap[addr] {
+// makeClientKind makes a new specific grpc client kind for the connection.
+func makeClientKind(kindVer string, conn *grpc.ClientConn) (interface{}, error) {
+switch kindVer {
 default:
-	return errors.New("invalid client kindVer: " + ctxt.AddrKindVerMap[addr])
+	return nil, errors.New("invalid client kindVer: " + kindVer)
 `
 
 // Autogenerated code template: zservicing.go.
 const _PROTO_SERVICE_AUTOGEN_2 = `}
-if ctxt.AddrClientMap[addr] == nil {
-	return errors.New("failed to make client " + ctxt.AddrKindVerMap[addr] + " for " + addr)
-}
-return nil
 }
-// makeServerKind makes a new specific grpc server kind in the context.
-func makeServerKind(addr string, ctxt *ApiServiceContext) error {
-switch ctxt.AddrKindVerMap[addr] {
+// makeServerKind registers the implementation of a specific grpc server kind
+// on the server and returns it.
+func makeServerKind(kindVer string, grpcServer *grpc.Server, impl interface{}) (interface{}, error) {
+switch kindVer {
 default:
-	return errors.New("invalid impl kindVer: " + ctxt.AddrKindVerMap[addr])
+	return nil, errors.New("invalid impl kindVer: " + kindVer)
 `
 
 // Autogenerated code template: zservicing.go.
 const _PROTO_SERVICE_AUTOGEN_3 = `}
-return nil
 }
-// Specific kinds follow grpc request calls follow. Functions called by serviceMessageKinds.
+// Specific kinds follow grpc request calls follow. Functions called by serviceMessageKind.
 `
 
 // Autogenerated code template: zservicing.go.
 func protoServiceAutogenReqFunc(kind, version, method, serviceName, goImportName string) string {
 	format := `
-	func req_%s_%s_%s_%s(req *%s.%sRequest, ctxt *ApiServiceContext) error {
-		if addr, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("%s", "%s", req, ctxt); err != nil {
-			return err
+	func req_%s_%s_%s_%s(req *%s.%sRequest, ctxt *ApiServiceContext) (proto.Message, error) {
+		if addr, kindClient, grpcContext, grpcCancel, err := makeClientGrpcContextForMsg("%s", "%s", req, ctxt); err != nil {
+			return nil, err
 		} else {
 			defer grpcCancel()
-			client, ok := ctxt.AddrClientMap[addr].(%s.%sClient)
+			client, ok := kindClient.(%s.%sClient)
 			if !ok {
-				return errors.New("no client for "+addr)
+				return nil, errors.New("no client for "+addr)
 			}
 			if resp, err := client.%s(grpcContext, req); err != nil {
-				return err
-			} else if handler := ctxt.RespHandlerMap["%s/%s.%s"]; handler == nil {
-				return nil
+				return nil, err
+			} else if handler := ctxt.respHandlerMap["%s/%s.%s"]; handler == nil {
+				return resp, nil
 			} else if err := handler(resp); err != nil {
-				return err
+				return nil, err
+			} else {
+				return resp, nil
 			}
 		}
-		return nil
 	}
 	`
 	return fmt.Sprintf(format, goImportName, serviceName, version, method, goImportName, method,
@@ -145,54 +161,112 @@ func protoServiceAutogenReqFunc(kind, version, method, serviceName, goImportName
 // Autogenerated code template: zservicing.go.
 func protoServiceAutogenReqMsgKindCase(kind, version, method, serviceName, goImportName string) string {
 	str := fmt.Sprintf("case *%s.%sRequest:\n", goImportName, method)
-	if method == "ApiServe" {
+	reqFunc := fmt.Sprintf("req_%s_%s_%s_%s", goImportName, serviceName, version, method)
+	switch method {
+	default:
+		str += fmt.Sprintf("return %s(msg, ctxt)\n", reqFunc)
+	case "ApiServe":
 		// Start a new grpc server in the context before initial request.
-		format := `if err := newServer("%s", "%s", msg, ctxt); err != nil {
-			return err
-		} else `
-		str += fmt.Sprintf(format, kind, version)
-	}
-	format := `if err := req_%s_%s_%s_%s(msg, ctxt); err != nil {
-		return err
-	}`
-	str += fmt.Sprintf(format, goImportName, serviceName, version, method)
-	if method == "ApiUnserve" {
-		// Stop the grpc server and remove it from the context after the unserve request.
-		str += ` else if err := stopServer(fmt.Sprintf("%s:%d", msg.GetApiHostname(), msg.GetApiPort()), ctxt); err != nil {
-			return err
-		}`
-	}
-	str += "\n"
+		format := `if err := newServer("%s", msg, ctxt); err != nil {
+			return nil, err
+		}
+		return %s(msg, ctxt)
+		`
+		str += fmt.Sprintf(format, kind, reqFunc)
+	case "ApiUnserve":
+		// Stop serving the service after the unserve request, and stop the grpc server
+		// and remove it from the context once it serves no other service.
+		format := `if resp, err := %s(msg, ctxt); err != nil {
+			return nil, err
+		} else if err := stopServer(MessageAddr(msg), "%s", ctxt); err != nil {
+			return nil, err
+		} else {
+			return resp, nil
+		}
+		`
+		str += fmt.Sprintf(format, reqFunc, kind)
+	}
 	return str
 }
 
 // Stores information about the api for a single package.
 type protoPackageApiInfo struct {
-	PackageName  string
-	Version      string
-	GoImportName string
-	GoImportPath string
-	TypeNames    []string
-	ServiceInfos []*protoServiceInfo
+	PackageName   string
+	Version       string
+	GoImportName  string
+	GoImportPath  string
+	TypeNames     []string
+	ServiceInfos  []*protoServiceInfo
+	MessageFields map[string][]*protoFieldInfo // Fields of each message type by type name.
+	Comments      map[string]string            // Leading comments of each message type by type name.
+	EnumInfos     []*protoEnumInfo
+	FileDesc      *descriptor.FileDescriptorProto      // Descriptor of the proto file, without source info.
+	GoFiles       []*plugin.CodeGeneratorResponse_File // Generated go files of the proto file, to be written.
 }
 
 // Stores information about a specific service kind.
 type protoServiceInfo struct {
-	ServiceName string
-	MethodNames []string
+	ServiceName    string
+	MethodNames    []string
+	MethodInputs   map[string]string // Input message type names by method name.
+	MethodOutputs  map[string]string // Fully qualified output message type names by method name.
+	MethodComments map[string]string // Leading comments by method name.
+}
+
+// Stores information about a field of a message type.
+type protoFieldInfo struct {
+	JsonName string
+	GoName   string
+	Type     descriptor.FieldDescriptorProto_Type
+	TypeName string // Versioned name of a message or enum type, e.g. os.machine.image.v0.ArchType.
+	Repeated bool
+	Map      bool
+	Comment  string
+	Rules    *protoFieldRules // Validation rules declared by the field options, or nil if none.
+}
+
+// Stores the validation rules of a field.
+type protoFieldRules struct {
+	Required  bool
+	Min       *float64
+	Max       *float64
+	Pattern   string
+	Exclusive string
 }
 
+// Stores information about an enum type.
+type protoEnumInfo struct {
+	Name    string
+	Comment string
+	Values  []*protoEnumValueInfo
+}
+
+// Stores information about a value of an enum type.
+type protoEnumValueInfo struct {
+	Name    string
+	Comment string
+}
+
+// Options of the gogo generator for each compiled proto file.
+const _PROTO_GOGO_PARAMS = "plugins=grpc,paths=source_relative," +
+	"Mgoogle/protobuf/any.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/protobuf/api.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/protobuf/descriptor.proto=github.com/gogo/protobuf/protoc-gen-gogo/descriptor," +
+	"Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/protobuf/empty.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/protobuf/field_mask.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/protobuf/source_context.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/protobuf/struct.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/protobuf/type.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types," +
+	"Mgoogle/api/annotations.proto=github.com/gogo/googleapis/google/api"
+
 // protogen walks the api source tree and auto-generates all protocol buffer source
-// files in the correct locations, overwriting any existing files. Exits with
-// error on failure.
+// files in the correct locations, overwriting any existing files. Proto files
+// are parsed and compiled in parallel, without protoc or plugins installed.
+// Exits with error on failure.
 func protogen(ctxt *CodegenContext) {
-	if stdOut, stdErr, err := exe.Doexec("", "go", "install", "github.com/gogo/protobuf/protoc-gen-gogo"); err != nil {
-		exe.Fatal("installing protoc-gen-gogo", exe.ErrOutput(stdOut, stdErr, err), ctxt.ExeContext)
-	}
-	if stdOut, stdErr, err := exe.Doexec("", "go", "install", "golang.org/x/tools/cmd/goimports"); err != nil {
-		exe.Fatal("installing goimports", exe.ErrOutput(stdOut, stdErr, err), ctxt.ExeContext)
-	}
-
 	apiProtoDir := filepath.Clean(filepath.Join(ctxt.SrcRootDir, "pkg", "api"))
 	gogoprotobufDir := ""
 	protobufDir := ""
@@ -202,72 +276,89 @@ func protogen(ctxt *CodegenContext) {
 		gogoprotobufDir = filepath.Clean(dir)
 		protobufDir = filepath.Join(dir, "protobuf") // Standard Google includes.
 	}
+	importPaths := []string{ctxt.SrcRootDir, apiProtoDir, gogoprotobufDir, protobufDir}
 
-	descOutDir := filepath.Clean(filepath.Join(ctxt.SrcRootDir, "workspace", "desc"))
-	if err := os.MkdirAll(descOutDir, 0755); err != nil {
-		exe.Fatal("making desc output dir", err, ctxt.ExeContext)
-	}
-
-	outStr := "--gogo_out=plugins=grpc,paths=source_relative,"
-	outStr += "Mgoogle/protobuf/any.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/api.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/descriptor.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/empty.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/field_mask.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/source_context.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/struct.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/type.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types,"
-	outStr += "Mgoogle/api/annotations.proto=github.com/gogo/googleapis/google/api:."
-	baseArgs := []string{"-I=.", "-I=" + apiProtoDir, "-I=" + gogoprotobufDir, "-I=" + protobufDir, outStr}
-
-	runProtoc := func(pbFile string, wg *sync.WaitGroup, chPackageApiInfo chan<- *protoPackageApiInfo) {
-		wg.Add(1)
+	// The gogo generator plugins are shared, so only one file is generated at
+	// a time.
+	var generateMu sync.Mutex
+	compileProto := func(pbFile string, wg *sync.WaitGroup, chPackageApiInfo chan<- *protoPackageApiInfo) {
+		defer wg.Done()
 		importName, importPath := protoPathToImports(pbFile)
 		version := string(importName[strings.LastIndex(importName, "_")+1:])
-		descFile := filepath.Clean(filepath.Join(descOutDir, importName+".pb"))
-		args := append(baseArgs, "--descriptor_set_out="+descFile)
-		args = append(args, pbFile)
-		if stdOut, stdErr, err := exe.Doexec("", "protoc", args...); err != nil {
-			exe.Fatal("compiling protobuf", exe.ErrOutput(stdOut, stdErr, err), ctxt.ExeContext)
-		} else {
-			fmt.Println(pbFile)
+		parser := protoparse.Parser{ImportPaths: importPaths, IncludeSourceCodeInfo: true}
+		fileDescs, err := parser.ParseFiles(pbFile)
+		if err != nil {
+			exe.Fatal("compiling protobuf", err, ctxt.ExeContext)
+		}
+		files, err := protoGogoFileDescriptors(fileDescs[0])
+		if err != nil {
+			exe.Fatal("converting protobuf desc", err, ctxt.ExeContext)
 		}
-		desc := &descriptor.FileDescriptorSet{}
-		if data, err := os.ReadFile(descFile); err != nil {
-			exe.Fatal("reading protobuf desc", err, ctxt.ExeContext)
-		} else if err = proto.Unmarshal(data, desc); err != nil {
-			exe.Fatal("unmarshaling protobuf desc", err, ctxt.ExeContext)
+		generateMu.Lock()
+		genFiles, err := protoGenerateGogo(&plugin.CodeGeneratorRequest{
+			FileToGenerate: []string{pbFile},
+			Parameter:      proto.String(_PROTO_GOGO_PARAMS),
+			ProtoFile:      files,
+		})
+		generateMu.Unlock()
+		if err != nil {
+			exe.Fatal("generating protobuf code", err, ctxt.ExeContext)
 		}
+		fmt.Println(pbFile)
+		f := files[len(files)-1]
+		fileDesc := proto.Clone(f).(*descriptor.FileDescriptorProto)
+		fileDesc.SourceCodeInfo = nil
 		var typeNames []string
 		var serviceInfos []*protoServiceInfo
-		var packageName string
-		for _, f := range desc.File {
-			packageName = *f.Package
-			for _, msgType := range f.MessageType {
-				typeNames = append(typeNames, *msgType.Name)
+		var enumInfos []*protoEnumInfo
+		messageFields := make(map[string][]*protoFieldInfo)
+		messageComments := make(map[string]string)
+		comments := protoLeadingComments(f)
+		versions := protoPackageVersions(f, version)
+		for i, msgType := range f.MessageType {
+			typeNames = append(typeNames, *msgType.Name)
+			messageFields[*msgType.Name] = protoMessageFields(msgType, comments, fmt.Sprintf("4,%d", i), versions)
+			messageComments[*msgType.Name] = comments[fmt.Sprintf("4,%d", i)]
+		}
+		for i, enumType := range f.EnumType {
+			enumInfo := &protoEnumInfo{Name: *enumType.Name, Comment: comments[fmt.Sprintf("5,%d", i)]}
+			for j, value := range enumType.Value {
+				enumInfo.Values = append(enumInfo.Values, &protoEnumValueInfo{
+					Name:    value.GetName(),
+					Comment: comments[fmt.Sprintf("5,%d,2,%d", i, j)],
+				})
 			}
-			for _, service := range f.Service {
-				serviceInfo := &protoServiceInfo{
-					ServiceName: *service.Name,
-				}
-				for _, method := range service.Method {
-					serviceInfo.MethodNames = append(serviceInfo.MethodNames, *method.Name)
-				}
-				serviceInfos = append(serviceInfos, serviceInfo)
+			enumInfos = append(enumInfos, enumInfo)
+		}
+		for i, service := range f.Service {
+			serviceInfo := &protoServiceInfo{
+				ServiceName:    *service.Name,
+				MethodInputs:   make(map[string]string),
+				MethodOutputs:  make(map[string]string),
+				MethodComments: make(map[string]string),
 			}
+			for j, method := range service.Method {
+				serviceInfo.MethodNames = append(serviceInfo.MethodNames, *method.Name)
+				inputType := method.GetInputType()
+				serviceInfo.MethodInputs[*method.Name] = inputType[strings.LastIndex(inputType, ".")+1:]
+				serviceInfo.MethodOutputs[*method.Name] = strings.TrimPrefix(method.GetOutputType(), ".")
+				serviceInfo.MethodComments[*method.Name] = comments[fmt.Sprintf("6,%d,2,%d", i, j)]
+			}
+			serviceInfos = append(serviceInfos, serviceInfo)
 		}
 		chPackageApiInfo <- &protoPackageApiInfo{
-			PackageName:  packageName,
-			Version:      version,
-			GoImportName: importName,
-			GoImportPath: importPath,
-			TypeNames:    typeNames,
-			ServiceInfos: serviceInfos,
+			PackageName:   f.GetPackage(),
+			Version:       version,
+			GoImportName:  importName,
+			GoImportPath:  importPath,
+			TypeNames:     typeNames,
+			ServiceInfos:  serviceInfos,
+			MessageFields: messageFields,
+			Comments:      messageComments,
+			EnumInfos:     enumInfos,
+			FileDesc:      fileDesc,
+			GoFiles:       genFiles,
 		}
-		wg.Done()
 	}
 
 	wg := &sync.WaitGroup{}
@@ -277,13 +368,13 @@ func protogen(ctxt *CodegenContext) {
 			return nil
 		}
 		pbFile := strings.Trim(strings.TrimPrefix(pathname, ctxt.SrcRootDir), "/\\")
-		pbFile = "./" + strings.ReplaceAll(pbFile, "\\", "/")
-		runProtoc(pbFile, wg, chPackageApiInfo)
+		pbFile = strings.ReplaceAll(pbFile, "\\", "/")
+		wg.Add(1)
+		go compileProto(pbFile, wg, chPackageApiInfo)
 		return nil
 	})
 	wg.Wait()
 	close(chPackageApiInfo)
-	os.RemoveAll(descOutDir)
 
 	// Consume all the info for generated packages and generate the go code for
 	// marshaling and grpc servicing.
@@ -291,13 +382,162 @@ func protogen(ctxt *CodegenContext) {
 	for info := range chPackageApiInfo {
 		pkgInfos = append(pkgInfos, info)
 	}
+	sort.Slice(pkgInfos, func(i, j int) bool { return pkgInfos[i].GoImportPath < pkgInfos[j].GoImportPath })
+
+	// Refuse to overwrite generated code if the api has breaking changes from
+	// the baseline, unless allowed.
+	current := &descriptor.FileDescriptorSet{}
+	for _, info := range pkgInfos {
+		current.File = append(current.File, info.FileDesc)
+	}
+	protoCheckBreaking(current, ctxt)
+	for _, info := range pkgInfos {
+		for _, goFile := range info.GoFiles {
+			outFilename := filepath.Join(ctxt.SrcRootDir, filepath.FromSlash(goFile.GetName()))
+			if err := os.WriteFile(outFilename, []byte(goFile.GetContent()), 0644); err != nil {
+				exe.Fatal("writing "+outFilename, err, ctxt.ExeContext)
+			}
+		}
+	}
 	protoGenerateMarshaling(pkgInfos, ctxt)
+	protoGenerateConverting(pkgInfos, ctxt)
 	protoGenerateServicing(pkgInfos, ctxt)
+	protoGenerateCommands(pkgInfos, ctxt)
+	protoGenerateClients(pkgInfos, ctxt)
+	protoGenerateSchema(pkgInfos, ctxt)
+	protoGenerateValidating(pkgInfos, ctxt)
+	protoSaveBaseline(current, ctxt)
+}
+
+// protoLeadingComments returns the leading comments of the elements of the file
+// as single lines, keyed by their comma-separated source location path.
+func protoLeadingComments(f *descriptor.FileDescriptorProto) map[string]string {
+	comments := make(map[string]string)
+	for _, loc := range f.GetSourceCodeInfo().GetLocation() {
+		if loc.LeadingComments == nil {
+			continue
+		}
+		var path []string
+		for _, p := range loc.Path {
+			path = append(path, strconv.Itoa(int(p)))
+		}
+		comments[strings.Join(path, ",")] = strings.Join(strings.Fields(*loc.LeadingComments), " ")
+	}
+	return comments
+}
+
+// protoPackageVersions returns the version of the package of the file and of
+// each package it imports from the api source tree, by package name. Imported
+// packages and their versions are named by the directories of their files, as
+// for Go import names.
+func protoPackageVersions(f *descriptor.FileDescriptorProto, version string) map[string]string {
+	versions := map[string]string{f.GetPackage(): version}
+	for _, dep := range f.Dependency {
+		dir := path.Dir(dep)
+		if depVersion := path.Base(dir); protoVersionRe.MatchString(depVersion) && path.Dir(dir) != "." {
+			versions[strings.ReplaceAll(path.Dir(dir), "/", ".")] = depVersion
+		}
+	}
+	return versions
+}
+
+// protoVersionedTypeName returns the fully qualified type name with the version
+// of its package inserted after the package name, or the name unchanged if the
+// version of its package is unknown.
+func protoVersionedTypeName(typeName string, versions map[string]string) string {
+	typeName = strings.TrimPrefix(typeName, ".")
+	if i := strings.LastIndex(typeName, "."); i >= 0 {
+		if version, ok := versions[typeName[:i]]; ok {
+			return typeName[:i] + "." + version + typeName[i:]
+		}
+	}
+	return typeName
+}
+
+// protoMessageFields returns information about the fields of the message type,
+// whose source location path is msgPath. Map fields are reported as single
+// message fields.
+func protoMessageFields(msgType *descriptor.DescriptorProto, comments map[string]string,
+	msgPath string, versions map[string]string) []*protoFieldInfo {
+
+	mapEntries := make(map[string]bool)
+	for _, nested := range msgType.NestedType {
+		if nested.GetOptions().GetMapEntry() {
+			mapEntries[nested.GetName()] = true
+		}
+	}
+	var fields []*protoFieldInfo
+	for i, field := range msgType.Field {
+		typeName := field.GetTypeName()
+		isMap := mapEntries[typeName[strings.LastIndex(typeName, ".")+1:]]
+		jsonName := field.GetJsonName()
+		if jsonName == "" {
+			jsonName = protoJsonName(field.GetName())
+		}
+		fields = append(fields, &protoFieldInfo{
+			JsonName: jsonName,
+			GoName:   generator.CamelCase(field.GetName()),
+			Type:     field.GetType(),
+			TypeName: protoVersionedTypeName(typeName, versions),
+			Repeated: field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED && !isMap,
+			Map:      isMap,
+			Comment:  comments[fmt.Sprintf("%s,2,%d", msgPath, i)],
+			Rules:    protoFieldValidationRules(field.GetOptions()),
+		})
+	}
+	return fields
+}
+
+// protoFieldValidationRules returns the validation rules declared by the
+// options of a field, or nil if it declares none.
+func protoFieldValidationRules(opts *descriptor.FieldOptions) *protoFieldRules {
+	if opts == nil {
+		return nil
+	}
+	rules := &protoFieldRules{}
+	declared := false
+	if ext, err := proto.GetExtension(opts, api_validate_v0.E_Required); err == nil {
+		rules.Required, declared = *ext.(*bool), true
+	}
+	if ext, err := proto.GetExtension(opts, api_validate_v0.E_Min); err == nil {
+		rules.Min, declared = ext.(*float64), true
+	}
+	if ext, err := proto.GetExtension(opts, api_validate_v0.E_Max); err == nil {
+		rules.Max, declared = ext.(*float64), true
+	}
+	if ext, err := proto.GetExtension(opts, api_validate_v0.E_Pattern); err == nil {
+		rules.Pattern, declared = *ext.(*string), true
+	}
+	if ext, err := proto.GetExtension(opts, api_validate_v0.E_Exclusive); err == nil {
+		rules.Exclusive, declared = *ext.(*string), true
+	}
+	if !declared {
+		return nil
+	}
+	return rules
+}
+
+// protoJsonName returns the default json name of a field, which is its name
+// converted to lower camel case.
+func protoJsonName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, c := range name {
+		if c == '_' {
+			upper = true
+		} else if upper {
+			b.WriteString(strings.ToUpper(string(c)))
+			upper = false
+		} else {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
 }
 
 // protoPathToImports converts the specified relative .proto path into a Go import name and path.
 func protoPathToImports(protoPath string) (string, string) {
-	name := path.Dir(strings.TrimPrefix(protoPath, "./pkg/api/"))
+	name := path.Dir(strings.TrimPrefix(protoPath, "pkg/api/"))
 	path := "alt-os/api/" + name
 	name = "api_" + strings.ReplaceAll(name, "/", "_")
 	return name, path
@@ -340,11 +580,92 @@ func protoGenerateMarshaling(pkgInfos []*protoPackageApiInfo, ctxt *CodegenConte
 		f.Close()
 	}
 
-	if stdOut, stdErr, err := exe.Doexec("", "goimports", "-w", outFilename); err != nil {
-		exe.Fatal("formatting "+outFilename, exe.ErrOutput(stdOut, stdErr, err), ctxt.ExeContext)
+	if err := protoFormatGoFile(outFilename); err != nil {
+		exe.Fatal("formatting "+outFilename, err, ctxt.ExeContext)
 	}
 }
 
+// protoGenerateConverting writes an autogenerated go file to the api package with
+// the known versions of each message kind and hooks for converting between them.
+func protoGenerateConverting(pkgInfos []*protoPackageApiInfo, ctxt *CodegenContext) {
+	type kindVersionInfo struct {
+		version string
+		pkgInfo *protoPackageApiInfo
+	}
+	kindVersionInfos := make(map[string][]*kindVersionInfo)
+	var kinds []string
+	for _, pkgInfo := range pkgInfos {
+		for _, typeName := range pkgInfo.TypeNames {
+			kind := pkgInfo.PackageName + "." + typeName
+			if _, ok := kindVersionInfos[kind]; !ok {
+				kinds = append(kinds, kind)
+			}
+			kindVersionInfos[kind] = append(kindVersionInfos[kind], &kindVersionInfo{pkgInfo.Version, pkgInfo})
+		}
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		infos := kindVersionInfos[kind]
+		sort.Slice(infos, func(i, j int) bool { return protoVersionLess(infos[i].version, infos[j].version) })
+	}
+
+	outFilename := filepath.Clean(filepath.Join(ctxt.SrcRootDir, "pkg", "api", "zconverting.go"))
+	if f, err := os.Create(outFilename); err != nil {
+		exe.Fatal("creating "+outFilename, err, ctxt.ExeContext)
+	} else {
+		f.WriteString(_PROTO_IMPORT_AUTOGEN)
+		for _, pkgInfo := range pkgInfos {
+			f.WriteString(fmt.Sprintf("\t%s \"%s\"\n", pkgInfo.GoImportName, pkgInfo.GoImportPath))
+		}
+		f.WriteString(_PROTO_CONVERT_AUTOGEN_0)
+		for _, kind := range kinds {
+			var versions []string
+			for _, info := range kindVersionInfos[kind] {
+				versions = append(versions, fmt.Sprintf("%q", info.version))
+			}
+			f.WriteString(fmt.Sprintf("\t%q: {%s},\n", kind, strings.Join(versions, ", ")))
+		}
+		f.WriteString(_PROTO_CONVERT_AUTOGEN_1)
+		for _, kind := range kinds {
+			infos := kindVersionInfos[kind]
+			typeName := kind[strings.LastIndex(kind, ".")+1:]
+			for i := 1; i < len(infos); i++ {
+				oldInfo, newInfo := infos[i-1], infos[i]
+				if !protoWireCompatible(typeName, oldInfo.pkgInfo.FileDesc, newInfo.pkgInfo.FileDesc) {
+					// Changed fields would be mismapped by wire format, so converting
+					// requires a registered converter.
+					f.WriteString(fmt.Sprintf("// %s changed from %s to %s: no default converter.\n",
+						kind, oldInfo.version, newInfo.version))
+					continue
+				}
+				format := `registerDefaultConverter("%s", "%s", "%s", func() proto.Message { return &%s.%s{} })
+				registerDefaultConverter("%s", "%s", "%s", func() proto.Message { return &%s.%s{} })
+				`
+				f.WriteString(fmt.Sprintf(format, kind, oldInfo.version, newInfo.version,
+					newInfo.pkgInfo.GoImportName, typeName, kind, newInfo.version, oldInfo.version,
+					oldInfo.pkgInfo.GoImportName, typeName))
+			}
+		}
+		f.WriteString(_PROTO_CONVERT_AUTOGEN_2)
+		f.Close()
+	}
+
+	if err := protoFormatGoFile(outFilename); err != nil {
+		exe.Fatal("formatting "+outFilename, err, ctxt.ExeContext)
+	}
+}
+
+// protoVersionLess returns whether api version a is older than version b, comparing
+// the numbers of versions of the form v<number> numerically.
+func protoVersionLess(a, b string) bool {
+	aNum, aErr := strconv.Atoi(strings.TrimPrefix(a, "v"))
+	bNum, bErr := strconv.Atoi(strings.TrimPrefix(b, "v"))
+	if aErr == nil && bErr == nil {
+		return aNum < bNum
+	}
+	return a < b
+}
+
 // protoGenerateServicing writes an autogenerated go file to the api package for
 // grpc servicing.
 func protoGenerateServicing(pkgInfos []*protoPackageApiInfo, ctxt *CodegenContext) {
@@ -372,7 +693,7 @@ func protoGenerateServicing(pkgInfos []*protoPackageApiInfo, ctxt *CodegenContex
 			for _, serviceInfo := range pkgInfo.ServiceInfos {
 				format := `
 				case "%s.%s/%s":
-					ctxt.AddrClientMap[addr] = %s.New%sClient(ctxt.AddrGrpcConnMap[addr])`
+					return %s.New%sClient(conn), nil`
 				f.WriteString(fmt.Sprintf(format, pkgInfo.PackageName, serviceInfo.ServiceName,
 					pkgInfo.Version, pkgInfo.GoImportName, serviceInfo.ServiceName))
 			}
@@ -380,14 +701,37 @@ func protoGenerateServicing(pkgInfos []*protoPackageApiInfo, ctxt *CodegenContex
 		f.WriteString(_PROTO_SERVICE_AUTOGEN_2)
 		for _, pkgInfo := range pkgInfos {
 			for _, serviceInfo := range pkgInfo.ServiceInfos {
+				kind := fmt.Sprintf("%s.%s", pkgInfo.PackageName, serviceInfo.ServiceName)
+				serverType := fmt.Sprintf("%s.%sServer", pkgInfo.GoImportName, serviceInfo.ServiceName)
 				format := `
-				case "%s.%s/%s":
-					srv := ctxt.KindImplMap[ctxt.AddrKindVerMap[addr]].(%s.%sServer)
-					%s.Register%sServer(ctxt.AddrGrpcServerMap[addr], srv)
-					ctxt.AddrServerMap[addr] = srv`
-				f.WriteString(fmt.Sprintf(format, pkgInfo.PackageName, serviceInfo.ServiceName,
-					pkgInfo.Version, pkgInfo.GoImportName, serviceInfo.ServiceName,
-					pkgInfo.GoImportName, serviceInfo.ServiceName))
+				case "%s/%s":
+					srv, ok := impl.(%s)
+					if !ok {
+						return nil, errors.New("invalid impl for kindVer: " + kindVer)
+					}
+					grpcServer.RegisterService(&grpc.ServiceDesc{
+						ServiceName: "%s",
+						HandlerType: (*%s)(nil),
+						Methods: []grpc.MethodDesc{`
+				f.WriteString(fmt.Sprintf(format, kind, pkgInfo.Version, serverType, kind, serverType))
+				for _, method := range serviceInfo.MethodNames {
+					format := `
+						{
+							MethodName: "%s",
+							Handler: convertingMethodHandler("/%s/%s", "%s.%s", "%s",
+								func(srv interface{}, ctx context.Context, req proto.Message) (proto.Message, error) {
+									return srv.(%s).%s(ctx, req.(*%s.%s))
+								}),
+						},`
+					f.WriteString(fmt.Sprintf(format, method, kind, method, pkgInfo.PackageName,
+						serviceInfo.MethodInputs[method], pkgInfo.Version, serverType, method,
+						pkgInfo.GoImportName, serviceInfo.MethodInputs[method]))
+				}
+				f.WriteString(`
+						},
+						Metadata: "` + pkgInfo.FileDesc.GetName() + `",
+					}, srv)
+					return srv, nil`)
 			}
 		}
 		f.WriteString(_PROTO_SERVICE_AUTOGEN_3)
@@ -404,7 +748,311 @@ func protoGenerateServicing(pkgInfos []*protoPackageApiInfo, ctxt *CodegenContex
 		f.Close()
 	}
 
-	if stdOut, stdErr, err := exe.Doexec("", "goimports", "-w", outFilename); err != nil {
-		exe.Fatal("formatting "+outFilename, exe.ErrOutput(stdOut, stdErr, err), ctxt.ExeContext)
+	if err := protoFormatGoFile(outFilename); err != nil {
+		exe.Fatal("formatting "+outFilename, err, ctxt.ExeContext)
+	}
+}
+
+// Autogenerated code template: zcommands.go.
+const _PROTO_COMMAND_AUTOGEN_0 = `// Code generated by codegen. DO NOT EDIT.
+package main
+
+// altctlCommands holds the command of each method of the latest version of
+// each service.
+var altctlCommands = []*altctlCommand{
+`
+
+// Autogenerated code template: zcommands.go.
+const _PROTO_COMMAND_AUTOGEN_1 = `}
+`
+
+// Flag types of the fields of altctl commands by protobuf field type.
+var protoCommandFieldTypes = map[descriptor.FieldDescriptorProto_Type]string{
+	descriptor.FieldDescriptorProto_TYPE_DOUBLE:   "_FIELD_FLOAT",
+	descriptor.FieldDescriptorProto_TYPE_FLOAT:    "_FIELD_FLOAT",
+	descriptor.FieldDescriptorProto_TYPE_INT64:    "_FIELD_INT",
+	descriptor.FieldDescriptorProto_TYPE_UINT64:   "_FIELD_UINT",
+	descriptor.FieldDescriptorProto_TYPE_INT32:    "_FIELD_INT",
+	descriptor.FieldDescriptorProto_TYPE_FIXED64:  "_FIELD_UINT",
+	descriptor.FieldDescriptorProto_TYPE_FIXED32:  "_FIELD_UINT",
+	descriptor.FieldDescriptorProto_TYPE_BOOL:     "_FIELD_BOOL",
+	descriptor.FieldDescriptorProto_TYPE_STRING:   "_FIELD_STRING",
+	descriptor.FieldDescriptorProto_TYPE_GROUP:    "_FIELD_MESSAGE",
+	descriptor.FieldDescriptorProto_TYPE_MESSAGE:  "_FIELD_MESSAGE",
+	descriptor.FieldDescriptorProto_TYPE_BYTES:    "_FIELD_BYTES",
+	descriptor.FieldDescriptorProto_TYPE_UINT32:   "_FIELD_UINT",
+	descriptor.FieldDescriptorProto_TYPE_ENUM:     "_FIELD_ENUM",
+	descriptor.FieldDescriptorProto_TYPE_SFIXED32: "_FIELD_INT",
+	descriptor.FieldDescriptorProto_TYPE_SFIXED64: "_FIELD_INT",
+	descriptor.FieldDescriptorProto_TYPE_SINT32:   "_FIELD_INT",
+	descriptor.FieldDescriptorProto_TYPE_SINT64:   "_FIELD_INT",
+}
+
+// protoGenerateCommands writes an autogenerated go file to the altctl executable
+// with a command for each method of the latest version of each service, except
+// for ApiServe since serving is up to the service executables.
+func protoGenerateCommands(pkgInfos []*protoPackageApiInfo, ctxt *CodegenContext) {
+	latestInfos := make(map[string]*protoPackageApiInfo)
+	var packageNames []string
+	for _, pkgInfo := range pkgInfos {
+		if len(pkgInfo.ServiceInfos) == 0 {
+			continue
+		}
+		if latest, ok := latestInfos[pkgInfo.PackageName]; !ok {
+			packageNames = append(packageNames, pkgInfo.PackageName)
+			latestInfos[pkgInfo.PackageName] = pkgInfo
+		} else if protoVersionLess(latest.Version, pkgInfo.Version) {
+			latestInfos[pkgInfo.PackageName] = pkgInfo
+		}
+	}
+	sort.Strings(packageNames)
+
+	outFilename := filepath.Clean(filepath.Join(ctxt.SrcRootDir, "pkg", "exe", "altctl", "zcommands.go"))
+	if f, err := os.Create(outFilename); err != nil {
+		exe.Fatal("creating "+outFilename, err, ctxt.ExeContext)
+	} else {
+		f.WriteString(_PROTO_COMMAND_AUTOGEN_0)
+		for _, packageName := range packageNames {
+			pkgInfo := latestInfos[packageName]
+			pkgPath := strings.Split(packageName, ".")
+			if len(pkgPath) > 1 {
+				pkgPath = pkgPath[1:]
+			}
+			for _, serviceInfo := range pkgInfo.ServiceInfos {
+				for _, method := range serviceInfo.MethodNames {
+					if method == "ApiServe" {
+						continue
+					}
+					var path []string
+					for _, name := range append(pkgPath, protoKebabCase(method)) {
+						path = append(path, fmt.Sprintf("%q", name))
+					}
+					inputName := serviceInfo.MethodInputs[method]
+					format := `{
+						path:    []string{%s},
+						kind:    %q,
+						version: %q,
+						usage:   %q,
+						fields: []*altctlField{
+					`
+					f.WriteString(fmt.Sprintf(format, strings.Join(path, ", "), packageName+"."+inputName,
+						pkgInfo.Version, serviceInfo.MethodComments[method]))
+					for _, field := range pkgInfo.MessageFields[inputName] {
+						format := "{name: %q, fieldType: %s, repeated: %t, usage: %q},\n"
+						f.WriteString(fmt.Sprintf(format, field.JsonName, protoCommandFieldTypes[field.Type],
+							field.Repeated, field.Comment))
+					}
+					f.WriteString("},\n},\n")
+				}
+			}
+		}
+		f.WriteString(_PROTO_COMMAND_AUTOGEN_1)
+		f.Close()
+	}
+
+	if err := protoFormatGoFile(outFilename); err != nil {
+		exe.Fatal("formatting "+outFilename, err, ctxt.ExeContext)
+	}
+}
+
+// protoKebabCase converts a camel case name to lower kebab case.
+func protoKebabCase(name string) string {
+	var b strings.Builder
+	for i, c := range name {
+		if c >= 'A' && c <= 'Z' {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			c += 'a' - 'A'
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// Autogenerated code template: client/<service>/zclient.go.
+const _PROTO_CLIENT_AUTOGEN_0 = `// Code generated by codegen. DO NOT EDIT.
+
+// Package %[1]s is the typed client of the %[2]s service.
+package %[1]s
+
+import (
+	"context"
+
+	"alt-os/client"
+	%[3]s "%[4]s"
+)
+
+// Kind and version of the service.
+const (
+	SERVICE_KIND    = %[5]q
+	SERVICE_VERSION = %[6]q
+)
+
+// Client calls the methods of the %[2]s service.
+type Client struct {
+	conn   *client.Conn
+	client %[3]s.%[2]sClient
+}
+
+// Dial connects to the API server at the address, as client.Dial does, and
+// returns a client of the service.
+func Dial(addr string, opts ...client.Option) (*Client, error) {
+	conn, err := client.Dial(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(conn), nil
+}
+
+// NewClient returns a client of the service on the connection.
+func NewClient(conn *client.Conn) *Client {
+	return &Client{conn: conn, client: %[3]s.New%[2]sClient(conn.ClientConn())}
+}
+
+// Close closes the connection of the client.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+`
+
+// Autogenerated code template: client/<service>/zclient.go.
+const _PROTO_CLIENT_AUTOGEN_METHOD = `
+// %[1]s
+func (c *Client) %[2]s(ctx context.Context, req *%[3]s.%[4]s) (*%[3]s.%[5]s, error) {
+	return c.client.%[2]s(ctx, req)
+}
+`
+
+// Autogenerated code template: client/<service>/zclient.go.
+const _PROTO_CLIENT_AUTOGEN_EMPTY_METHOD = `
+// %[1]s
+func (c *Client) %[2]s(ctx context.Context, req *%[3]s.%[4]s) error {
+	_, err := c.client.%[2]s(ctx, req)
+	return err
+}
+`
+
+// Autogenerated code template: client/<service>/zfake.go.
+const _PROTO_FAKE_AUTOGEN_0 = `// Code generated by codegen. DO NOT EDIT.
+package %[1]s
+
+import (
+	"context"
+
+	"alt-os/client"
+	"alt-os/client/fake"
+	%[3]s "%[4]s"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+)
+
+// Fake is an in-memory fake of the %[2]s service, which records the calls
+// made to it and returns the responses and errors programmed for each method.
+type Fake struct {
+	*fake.Server
+}
+
+// NewFake starts serving a fake of the service on an in-memory listener.
+func NewFake() *Fake {
+	f := &Fake{}
+	f.Server = fake.NewServer(func(grpcServer *grpc.Server) {
+		%[3]s.Register%[2]sServer(grpcServer, f)
+	})
+	return f
+}
+
+// Dial returns a client of the fake.
+func (f *Fake) Dial(opts ...client.Option) (*Client, error) {
+	return Dial(fake.ADDR, append(opts, client.WithDialOptions(f.DialOption()))...)
+}
+`
+
+// Autogenerated code template: client/<service>/zfake.go.
+const _PROTO_FAKE_AUTOGEN_METHOD = `
+// %[2]s records the call and returns the programmed response or error.
+func (f *Fake) %[2]s(ctx context.Context, req *%[3]s.%[4]s) (*%[5]s, error) {
+	resp, err := f.Handle(ctx, %[2]q, req, &%[5]s{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*%[5]s), nil
+}
+`
+
+// protoGenerateClients writes an autogenerated go package to the client
+// package for the latest version of each service, named by the service name
+// without its Service suffix in lower case, with a typed method for each
+// method of the service except for ApiServe, and an in-memory fake of the
+// service.
+func protoGenerateClients(pkgInfos []*protoPackageApiInfo, ctxt *CodegenContext) {
+	latestInfos := make(map[string]*protoPackageApiInfo)
+	for _, pkgInfo := range pkgInfos {
+		if len(pkgInfo.ServiceInfos) == 0 {
+			continue
+		}
+		if latest, ok := latestInfos[pkgInfo.PackageName]; !ok || protoVersionLess(latest.Version, pkgInfo.Version) {
+			latestInfos[pkgInfo.PackageName] = pkgInfo
+		}
+	}
+
+	for packageName, pkgInfo := range latestInfos {
+		for _, serviceInfo := range pkgInfo.ServiceInfos {
+			clientName := strings.ToLower(strings.TrimSuffix(serviceInfo.ServiceName, "Service"))
+			outDir := filepath.Clean(filepath.Join(ctxt.SrcRootDir, "pkg", "client", clientName))
+			if err := os.MkdirAll(outDir, 0755); err != nil {
+				exe.Fatal("making client output dir", err, ctxt.ExeContext)
+			}
+			outFilename := filepath.Join(outDir, "zclient.go")
+			if f, err := os.Create(outFilename); err != nil {
+				exe.Fatal("creating "+outFilename, err, ctxt.ExeContext)
+			} else {
+				f.WriteString(fmt.Sprintf(_PROTO_CLIENT_AUTOGEN_0, clientName, serviceInfo.ServiceName,
+					pkgInfo.GoImportName, pkgInfo.GoImportPath, packageName+"."+serviceInfo.ServiceName, pkgInfo.Version))
+				for _, method := range serviceInfo.MethodNames {
+					if method == "ApiServe" {
+						continue
+					}
+					comment := serviceInfo.MethodComments[method]
+					if comment == "" {
+						comment = method + " calls the " + method + " method of the service."
+					}
+					output := serviceInfo.MethodOutputs[method]
+					if output == "google.protobuf.Empty" {
+						f.WriteString(fmt.Sprintf(_PROTO_CLIENT_AUTOGEN_EMPTY_METHOD, comment, method,
+							pkgInfo.GoImportName, serviceInfo.MethodInputs[method]))
+					} else {
+						f.WriteString(fmt.Sprintf(_PROTO_CLIENT_AUTOGEN_METHOD, comment, method,
+							pkgInfo.GoImportName, serviceInfo.MethodInputs[method], output[strings.LastIndex(output, ".")+1:]))
+					}
+				}
+				f.Close()
+			}
+			if err := protoFormatGoFile(outFilename); err != nil {
+				exe.Fatal("formatting "+outFilename, err, ctxt.ExeContext)
+			}
+
+			outFilename = filepath.Join(outDir, "zfake.go")
+			if f, err := os.Create(outFilename); err != nil {
+				exe.Fatal("creating "+outFilename, err, ctxt.ExeContext)
+			} else {
+				f.WriteString(fmt.Sprintf(_PROTO_FAKE_AUTOGEN_0, clientName, serviceInfo.ServiceName,
+					pkgInfo.GoImportName, pkgInfo.GoImportPath))
+				for _, method := range serviceInfo.MethodNames {
+					output := serviceInfo.MethodOutputs[method]
+					outputType := "types." + output[strings.LastIndex(output, ".")+1:]
+					if !strings.HasPrefix(output, "google.protobuf.") {
+						outputType = pkgInfo.GoImportName + "." + output[strings.LastIndex(output, ".")+1:]
+					}
+					f.WriteString(fmt.Sprintf(_PROTO_FAKE_AUTOGEN_METHOD, clientName, method,
+						pkgInfo.GoImportName, serviceInfo.MethodInputs[method], outputType))
+				}
+				f.Close()
+			}
+			if err := protoFormatGoFile(outFilename); err != nil {
+				exe.Fatal("formatting "+outFilename, err, ctxt.ExeContext)
+			}
+		}
 	}
 }
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"alt-os/exe"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// Version of JSON Schema of the generated schema.
+const _SCHEMA_DRAFT = "http://json-schema.org/draft-07/schema#"
+
+// Name of the definition of variable references in the generated schema.
+const _SCHEMA_VAR_REF = "varRef"
+
+// Matches ${VAR} and ${VAR:-default} variable references, as expanded by the
+// api package when reading definition files.
+const _SCHEMA_VAR_REF_PATTERN = `^\$\{[A-Za-z_][A-Za-z0-9_.]*(:-[^}]*)?\}$`
+
+// Package, version and type name of the message wrapping the definition of
+// each document.
+const (
+	_SCHEMA_API_PACKAGE = "api"
+	_SCHEMA_API_VERSION = "v0"
+	_SCHEMA_API_MESSAGE = "ApiMessage"
+)
+
+// JSON Schemas of the scalar field types that are not 64-bit integers.
+var protoSchemaScalars = map[descriptor.FieldDescriptorProto_Type]map[string]interface{}{
+	descriptor.FieldDescriptorProto_TYPE_DOUBLE:   {"type": "number"},
+	descriptor.FieldDescriptorProto_TYPE_FLOAT:    {"type": "number"},
+	descriptor.FieldDescriptorProto_TYPE_INT32:    {"type": "integer"},
+	descriptor.FieldDescriptorProto_TYPE_SINT32:   {"type": "integer"},
+	descriptor.FieldDescriptorProto_TYPE_SFIXED32: {"type": "integer"},
+	descriptor.FieldDescriptorProto_TYPE_UINT32:   {"type": "integer", "minimum": 0},
+	descriptor.FieldDescriptorProto_TYPE_FIXED32:  {"type": "integer", "minimum": 0},
+	descriptor.FieldDescriptorProto_TYPE_BOOL:     {"type": "boolean"},
+}
+
+// Patterns of the strings that 64-bit integer fields also accept in json.
+var protoSchemaInt64Patterns = map[descriptor.FieldDescriptorProto_Type]string{
+	descriptor.FieldDescriptorProto_TYPE_INT64:    "^-?[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_SINT64:   "^-?[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_SFIXED64: "^-?[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_UINT64:   "^[0-9]+$",
+	descriptor.FieldDescriptorProto_TYPE_FIXED64:  "^[0-9]+$",
+}
+
+// protoGenerateSchema writes a JSON Schema of definition files to the def
+// directory, so that editors can validate and complete them. The schema has a
+// definition of each message and enum type of each package version, and
+// describes a document of a definition file as an api message whose def is
+// selected by its kind and version.
+func protoGenerateSchema(pkgInfos []*protoPackageApiInfo, ctxt *CodegenContext) {
+	pkgInfos = append([]*protoPackageApiInfo(nil), pkgInfos...)
+	sort.Slice(pkgInfos, func(i, j int) bool {
+		if pkgInfos[i].PackageName != pkgInfos[j].PackageName {
+			return pkgInfos[i].PackageName < pkgInfos[j].PackageName
+		}
+		return protoVersionLess(pkgInfos[i].Version, pkgInfos[j].Version)
+	})
+	definitions := map[string]interface{}{
+		_SCHEMA_VAR_REF: map[string]interface{}{
+			"description": "A reference to a definition variable, which is expanded when the file is read.",
+			"type":        "string",
+			"pattern":     _SCHEMA_VAR_REF_PATTERN,
+		},
+	}
+	var kinds, versions []string
+	var kindSchemas []interface{}
+	hasVersion := make(map[string]bool)
+	for _, pkgInfo := range pkgInfos {
+		for _, typeName := range pkgInfo.TypeNames {
+			defName := pkgInfo.PackageName + "." + pkgInfo.Version + "." + typeName
+			definitions[defName] = protoMessageSchema(pkgInfo.Comments[typeName], pkgInfo.MessageFields[typeName])
+			kind := pkgInfo.PackageName + "." + typeName
+			kinds = append(kinds, kind)
+			kindSchemas = append(kindSchemas, map[string]interface{}{
+				"if": map[string]interface{}{
+					"properties": map[string]interface{}{
+						"kind":    map[string]interface{}{"const": kind},
+						"version": map[string]interface{}{"const": pkgInfo.Version},
+					},
+					"required": []string{"kind", "version"},
+				},
+				"then": map[string]interface{}{
+					"properties": map[string]interface{}{
+						"def": map[string]interface{}{"$ref": "#/definitions/" + defName},
+					},
+				},
+			})
+		}
+		for _, enumInfo := range pkgInfo.EnumInfos {
+			defName := pkgInfo.PackageName + "." + pkgInfo.Version + "." + enumInfo.Name
+			definitions[defName] = protoEnumSchema(enumInfo)
+		}
+		if !hasVersion[pkgInfo.Version] {
+			hasVersion[pkgInfo.Version] = true
+			versions = append(versions, pkgInfo.Version)
+		}
+	}
+	sort.Strings(kinds)
+	sort.Slice(versions, func(i, j int) bool { return protoVersionLess(versions[i], versions[j]) })
+
+	// A document is the message wrapping definitions, with its kind and version
+	// limited to known ones and its def selected by them.
+	var schema map[string]interface{}
+	for _, pkgInfo := range pkgInfos {
+		if pkgInfo.PackageName == _SCHEMA_API_PACKAGE && pkgInfo.Version == _SCHEMA_API_VERSION {
+			schema = protoMessageSchema(pkgInfo.Comments[_SCHEMA_API_MESSAGE], pkgInfo.MessageFields[_SCHEMA_API_MESSAGE])
+		}
+	}
+	if schema == nil {
+		exe.Fatal("generating schema", errors.New("no "+_SCHEMA_API_MESSAGE+" message"), ctxt.ExeContext)
+	}
+	properties := schema["properties"].(map[string]interface{})
+	properties["kind"].(map[string]interface{})["enum"] = kinds
+	properties["version"].(map[string]interface{})["enum"] = versions
+	properties["def"] = map[string]interface{}{
+		"type":        "object",
+		"description": properties["def"].(map[string]interface{})["description"],
+	}
+	schema["$schema"] = _SCHEMA_DRAFT
+	schema["title"] = "OS API definition"
+	schema["required"] = []string{"kind", "version"}
+	schema["allOf"] = kindSchemas
+	schema["definitions"] = definitions
+
+	outFilename := filepath.Clean(filepath.Join(ctxt.SrcRootDir, "def", "api.schema.json"))
+	if data, err := json.MarshalIndent(schema, "", "  "); err != nil {
+		exe.Fatal("marshaling schema", err, ctxt.ExeContext)
+	} else if err := os.WriteFile(outFilename, append(data, '\n'), 0644); err != nil {
+		exe.Fatal("writing "+outFilename, err, ctxt.ExeContext)
+	}
+}
+
+// protoMessageSchema returns the schema of a message type with the comment and
+// fields. Fields are named by their json names only, which is how formatted
+// definition files name them, and repeated fields may be left empty.
+func protoMessageSchema(comment string, fields []*protoFieldInfo) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for _, field := range fields {
+		fieldSchema := protoFieldSchema(field)
+		if field.Repeated {
+			fieldSchema = map[string]interface{}{"type": []string{"array", "null"}, "items": fieldSchema}
+		}
+		if field.Comment != "" {
+			fieldSchema["description"] = field.Comment
+		}
+		properties[field.JsonName] = fieldSchema
+	}
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if comment != "" {
+		schema["description"] = comment
+	}
+	return schema
+}
+
+// protoFieldSchema returns the schema of a single value of the field. Values
+// of fields that are not strings or messages may also be variable references.
+func protoFieldSchema(field *protoFieldInfo) map[string]interface{} {
+	varRef := map[string]interface{}{"$ref": "#/definitions/" + _SCHEMA_VAR_REF}
+	switch field.Type {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		if field.TypeName == "google.protobuf.Any" {
+			return map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"@type": map[string]interface{}{"type": "string"}},
+				"required":   []string{"@type"},
+			}
+		} else if strings.HasPrefix(field.TypeName, "google.protobuf.") {
+			return map[string]interface{}{}
+		}
+		return map[string]interface{}{"$ref": "#/definitions/" + field.TypeName}
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return map[string]interface{}{
+			"anyOf": []interface{}{map[string]interface{}{"$ref": "#/definitions/" + field.TypeName}, varRef},
+		}
+	case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return map[string]interface{}{"type": "string"}
+	}
+	if pattern, ok := protoSchemaInt64Patterns[field.Type]; ok {
+		return map[string]interface{}{
+			"anyOf": []interface{}{
+				map[string]interface{}{"type": "integer"},
+				map[string]interface{}{"type": "string", "pattern": pattern},
+				varRef,
+			},
+		}
+	}
+	return map[string]interface{}{"anyOf": []interface{}{protoSchemaScalars[field.Type], varRef}}
+}
+
+// protoEnumSchema returns the schema of an enum type, whose values are named
+// by their names in json.
+func protoEnumSchema(enumInfo *protoEnumInfo) map[string]interface{} {
+	var values []interface{}
+	for _, value := range enumInfo.Values {
+		valueSchema := map[string]interface{}{"const": value.Name}
+		if value.Comment != "" {
+			valueSchema["description"] = value.Comment
+		}
+		values = append(values, valueSchema)
+	}
+	schema := map[string]interface{}{"type": "string", "oneOf": values}
+	if enumInfo.Comment != "" {
+		schema["description"] = enumInfo.Comment
+	}
+	return schema
+}
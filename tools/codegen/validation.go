@@ -0,0 +1,193 @@
+package main
+
+import (
+	"alt-os/exe"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// Autogenerated code template: zvalidating.go.
+const _PROTO_VALIDATE_AUTOGEN_0 = `// Code generated by codegen. DO NOT EDIT.
+package %s
+
+import (
+	"regexp"
+	"strconv"
+
+	api_validate_v0 "alt-os/api/validate/v0"
+)
+`
+
+// Autogenerated code template: zvalidating.go.
+const _PROTO_VALIDATE_AUTOGEN_METHOD_0 = `
+// Validate returns the violations of the validation rules of the message and
+// of the messages of its fields, or nil if there are none.
+func (m *%s) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var v api_validate_v0.Violations
+`
+
+// Autogenerated code template: zvalidating.go.
+const _PROTO_VALIDATE_AUTOGEN_METHOD_1 = `	return v.Err()
+}
+`
+
+// protoGenerateValidating writes an autogenerated go file to each protobuf
+// package with a Validate method for each message type, which checks the
+// validation rules declared by the options of its fields and validates the
+// messages of its fields. Exits with error on rules that do not apply to the
+// type of their field.
+func protoGenerateValidating(pkgInfos []*protoPackageApiInfo, ctxt *CodegenContext) {
+	for _, pkgInfo := range pkgInfos {
+		if len(pkgInfo.TypeNames) == 0 {
+			continue
+		}
+		outFilename := filepath.Clean(filepath.Join(ctxt.SrcRootDir, "pkg",
+			strings.TrimPrefix(pkgInfo.GoImportPath, "alt-os/"), "zvalidating.go"))
+		var b strings.Builder
+		b.WriteString(fmt.Sprintf(_PROTO_VALIDATE_AUTOGEN_0, pkgInfo.Version))
+		for _, typeName := range pkgInfo.TypeNames {
+			code, err := protoValidateMessage(typeName, pkgInfo.MessageFields[typeName])
+			if err != nil {
+				exe.Fatal("generating validation of "+pkgInfo.PackageName+"."+typeName, err, ctxt.ExeContext)
+			}
+			b.WriteString(code)
+		}
+		if err := os.WriteFile(outFilename, []byte(b.String()), 0644); err != nil {
+			exe.Fatal("writing "+outFilename, err, ctxt.ExeContext)
+		}
+		if err := protoFormatGoFile(outFilename); err != nil {
+			exe.Fatal("formatting "+outFilename, err, ctxt.ExeContext)
+		}
+	}
+}
+
+// protoValidateMessage returns the Validate method and pattern variables of
+// the message type with the fields.
+func protoValidateMessage(typeName string, fields []*protoFieldInfo) (string, error) {
+	var vars, body strings.Builder
+	var groups []string
+	groupFields := make(map[string][]*protoFieldInfo)
+	for _, field := range fields {
+		value := "m." + field.GoName
+		if rules := field.Rules; rules != nil {
+			if rules.Exclusive != "" {
+				if _, ok := groupFields[rules.Exclusive]; !ok {
+					groups = append(groups, rules.Exclusive)
+				}
+				groupFields[rules.Exclusive] = append(groupFields[rules.Exclusive], field)
+			} else if rules.Required {
+				body.WriteString(fmt.Sprintf("\tif %s {\n\t\tv.Add(%q, \"required\")\n\t}\n",
+					protoUnsetExpr(field, value), field.JsonName))
+			}
+			var checks strings.Builder
+			if rules.Min != nil || rules.Max != nil {
+				if !protoIsNumeric(field.Type) {
+					return "", errors.New("min or max of non-numeric field " + field.JsonName)
+				}
+				if rules.Min != nil {
+					min := strconv.FormatFloat(*rules.Min, 'f', -1, 64)
+					checks.WriteString(fmt.Sprintf("\t\tif float64(x) < %s {\n\t\t\tv.Add(f, \"must be at least %s\")\n\t\t}\n", min, min))
+				}
+				if rules.Max != nil {
+					max := strconv.FormatFloat(*rules.Max, 'f', -1, 64)
+					checks.WriteString(fmt.Sprintf("\t\tif float64(x) > %s {\n\t\t\tv.Add(f, \"must be at most %s\")\n\t\t}\n", max, max))
+				}
+			}
+			if rules.Pattern != "" {
+				if field.Type != descriptor.FieldDescriptorProto_TYPE_STRING {
+					return "", errors.New("pattern of non-string field " + field.JsonName)
+				}
+				reName := "validate" + typeName + field.GoName + "Re"
+				vars.WriteString(fmt.Sprintf("\n// Matches valid values of %s.%s.\nvar %s = regexp.MustCompile(%q)\n",
+					typeName, field.JsonName, reName, rules.Pattern))
+				checks.WriteString(fmt.Sprintf("\t\tif !%s.MatchString(x) {\n\t\t\tv.Add(f, %q)\n\t\t}\n",
+					reName, "must match "+rules.Pattern))
+			}
+			if checks.Len() > 0 {
+				if field.Repeated {
+					body.WriteString(fmt.Sprintf("\tfor i, x := range %s {\n\t\tf := %q + strconv.Itoa(i) + \"]\"\n",
+						value, field.JsonName+"["))
+				} else {
+					body.WriteString(fmt.Sprintf("\tif x, f := %s, %q; %s {\n", value, field.JsonName,
+						protoSetExpr(field, "x")))
+				}
+				body.WriteString(checks.String() + "\t}\n")
+			}
+		}
+		if field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE && !field.Map &&
+			!strings.HasPrefix(field.TypeName, "google.protobuf.") {
+
+			if field.Repeated {
+				body.WriteString(fmt.Sprintf("\tfor i, x := range %s {\n\t\tv.AddNested(%q+strconv.Itoa(i)+\"]\", x.Validate())\n\t}\n",
+					value, field.JsonName+"["))
+			} else {
+				body.WriteString(fmt.Sprintf("\tv.AddNested(%q, %s.Validate())\n", field.JsonName, value))
+			}
+		}
+	}
+	for _, group := range groups {
+		var names, sets []string
+		required := false
+		for _, field := range groupFields[group] {
+			names = append(names, strconv.Quote(field.JsonName))
+			sets = append(sets, protoSetExpr(field, "m."+field.GoName))
+			required = required || field.Rules.Required
+		}
+		body.WriteString(fmt.Sprintf("\tv.AddExclusive([]string{%s}, []bool{%s}, %t)\n",
+			strings.Join(names, ", "), strings.Join(sets, ", "), required))
+	}
+	return vars.String() + fmt.Sprintf(_PROTO_VALIDATE_AUTOGEN_METHOD_0, typeName) +
+		body.String() + _PROTO_VALIDATE_AUTOGEN_METHOD_1, nil
+}
+
+// protoSetExpr returns a go expression of whether the value of the field is
+// set, i.e. does not have its default value.
+func protoSetExpr(field *protoFieldInfo, value string) string {
+	switch {
+	case field.Repeated || field.Map || field.Type == descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return "len(" + value + ") > 0"
+	case field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return value + " != nil"
+	case field.Type == descriptor.FieldDescriptorProto_TYPE_STRING:
+		return value + ` != ""`
+	case field.Type == descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return value
+	}
+	return value + " != 0"
+}
+
+// protoUnsetExpr returns a go expression of whether the value of the field is
+// not set, i.e. has its default value.
+func protoUnsetExpr(field *protoFieldInfo, value string) string {
+	switch {
+	case field.Repeated || field.Map || field.Type == descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return "len(" + value + ") == 0"
+	case field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return value + " == nil"
+	case field.Type == descriptor.FieldDescriptorProto_TYPE_STRING:
+		return value + ` == ""`
+	case field.Type == descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return "!" + value
+	}
+	return value + " == 0"
+}
+
+// protoIsNumeric returns whether values of the field type are numbers.
+func protoIsNumeric(fieldType descriptor.FieldDescriptorProto_Type) bool {
+	switch fieldType {
+	case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_BOOL, descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP, descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return false
+	}
+	return true
+}
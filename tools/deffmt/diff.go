@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Number of unchanged lines shown around each change of a diff.
+const _DIFF_CONTEXT_LINES = 2
+
+// lineDiff returns a unified diff of the lines of the original and formatted
+// contents of the file.
+func lineDiff(filename, original, formatted string) string {
+	a := strings.SplitAfter(original, "\n")
+	b := strings.SplitAfter(formatted, "\n")
+
+	// Find the longest common subsequence of lines from the end of each.
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// Walk the edit script, keeping unchanged lines near changes as context.
+	type line struct {
+		op   byte
+		text string
+		aNum int
+		bNum int
+	}
+	var lines []line
+	for i, j := 0, 0; i < len(a) || j < len(b); {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, line{' ', a[i], i, j})
+			i, j = i+1, j+1
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, line{'-', a[i], i, j})
+			i++
+		default:
+			lines = append(lines, line{'+', b[j], i, j})
+			j++
+		}
+	}
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s (formatted)\n", filename, filename)
+	lastShown := -1
+	for k, l := range lines {
+		near := false
+		for c := k - _DIFF_CONTEXT_LINES; c <= k+_DIFF_CONTEXT_LINES && !near; c++ {
+			near = c >= 0 && c < len(lines) && lines[c].op != ' '
+		}
+		if !near || (l.op == ' ' && l.text == "") {
+			continue
+		}
+		if lastShown != k-1 {
+			fmt.Fprintf(&out, "@@ -%d +%d @@\n", l.aNum+1, l.bNum+1)
+		}
+		text := l.text
+		if !strings.HasSuffix(text, "\n") {
+			text += "\n\\ No newline at end of file\n"
+		}
+		out.WriteString(string(l.op) + text)
+		lastShown = k
+	}
+	return out.String()
+}
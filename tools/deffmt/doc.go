@@ -0,0 +1,6 @@
+// Copyright © 2022. All rights reserved.
+
+//
+// Tool for formatting and checking API message definition files.
+//
+package main
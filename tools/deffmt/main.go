@@ -0,0 +1,160 @@
+package main
+
+import (
+	"alt-os/api"
+	api_os_container_bundle_v0 "alt-os/api/os/container/bundle/v0"
+	api_os_machine_image_v0 "alt-os/api/os/machine/image/v0"
+	api_validate_v0 "alt-os/api/validate/v0"
+	"alt-os/exe"
+	"alt-os/os/container"
+	"alt-os/os/machine"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const EXE_USAGE = `deffmt
+------
+Checks API message definition files against the schemas and validators of
+their kinds, and rewrites them with their fields in canonical order. Files
+whose documents have no kind are not definition files and are skipped. Exits
+with error status if any issue is found.
+`
+
+// Directory of definition files checked if no path is specified.
+const _DEFAULT_DEF_DIR = "def"
+
+// DeffmtContext holds context information for deffmt.
+type DeffmtContext struct {
+	*exe.ExeContext
+	Check  bool // Report unformatted files with a diff instead of rewriting them.
+	Issues int  // Number of issues found.
+}
+
+// main is the entry point.
+func main() {
+	// Parse command line.
+	ctxt := &DeffmtContext{ExeContext: &exe.ExeContext{}}
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "%s\n", EXE_USAGE)
+		fmt.Fprintf(os.Stderr, "Usage: deffmt [flags] [path ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.BoolVar(&ctxt.Check, "check", false, "Print a diff of unformatted files instead of rewriting them")
+	flag.Var(exe.DefineVarsFlag{}, "D", "Sets a key=value variable to substitute for ${key} in definition files (repeatable)")
+	flag.Parse()
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{_DEFAULT_DEF_DIR}
+	}
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(filename string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if ext := filepath.Ext(filename); !d.IsDir() && (ext == ".yml" || ext == ".yaml") {
+				formatFile(filename, ctxt)
+			}
+			return nil
+		})
+		if err != nil {
+			exe.Fatal("reading definitions", err, ctxt.ExeContext)
+		}
+	}
+
+	if ctxt.Issues > 0 {
+		exe.Fatal("checking definitions", fmt.Errorf("%d issue(s) found", ctxt.Issues), ctxt.ExeContext)
+	}
+	exe.Success(ctxt.ExeContext)
+}
+
+// formatFile checks the definition file and rewrites it formatted, or prints
+// a diff if checking. Files with issues are not rewritten.
+func formatFile(filename string, ctxt *DeffmtContext) {
+	issues := ctxt.Issues
+	formatted, isDef, err := api.FormatApiDefFile(filename)
+	if !isDef {
+		return
+	} else if err != nil {
+		ctxt.issue(err)
+		return
+	}
+	if messages, err := api.UnmarshalApiProtoMessages(filename, ""); err != nil {
+		ctxt.issue(err)
+	} else {
+		for i, msg := range messages {
+			if err := validateMessage(msg); err != nil {
+				ctxt.issue(fmt.Errorf("%s: document %d: %v", filename, i+1, err))
+			}
+		}
+	}
+	original, err := os.ReadFile(filename)
+	if err != nil {
+		ctxt.issue(err)
+		return
+	} else if bytes.Equal(original, formatted) {
+		return
+	}
+	if ctxt.Check {
+		ctxt.issue(errors.New(filename + ": not formatted"))
+		fmt.Print(lineDiff(filename, string(original), string(formatted)))
+	} else if ctxt.Issues > issues {
+		return
+	} else if info, err := os.Stat(filename); err != nil {
+		ctxt.issue(err)
+	} else if err := os.WriteFile(filename, formatted, info.Mode()); err != nil {
+		ctxt.issue(err)
+	} else {
+		fmt.Println(filename)
+	}
+}
+
+// validateMessage validates the definition of the message by the validation
+// rules of its type, then the virtual machine and bundle definitions in it by
+// the checks that the rules cannot declare.
+func validateMessage(msg *api.ApiProtoMessage) error {
+	var violations api_validate_v0.Violations
+	if validating, ok := msg.Def.(interface{ Validate() error }); ok {
+		if err := validating.Validate(); err != nil && !errors.As(err, &violations) {
+			return err
+		}
+	}
+	switch def := msg.Def.(type) {
+	case *api_os_machine_image_v0.VirtualMachine:
+		violations.AddNested("", machine.ValidateVirtualized(def))
+	case *api_os_machine_image_v0.CreateRequest:
+		for i, vm := range def.VirtualMachines {
+			violations.AddNested("virtualMachines["+strconv.Itoa(i)+"]", machine.ValidateVirtualized(vm))
+		}
+	case *api_os_container_bundle_v0.Bundle:
+		validateBundle(def, "", &violations)
+	case *api_os_container_bundle_v0.CreateRequest:
+		for i, bundle := range def.Bundles {
+			validateBundle(bundle, "bundles["+strconv.Itoa(i)+"]", &violations)
+		}
+	}
+	return violations.Err()
+}
+
+// validateBundle adds the violations of the checks of the bundle definition
+// that its validation rules cannot declare, prefixed by the field if any.
+func validateBundle(def *api_os_container_bundle_v0.Bundle, field string, violations *api_validate_v0.Violations) {
+	prefix := field
+	if prefix != "" {
+		prefix += "."
+	}
+	violations.AddNested(prefix+"process", container.ValidateProcess(def.Process))
+	violations.AddNested(prefix+"virtualMachine", machine.ValidateVirtualized(def.VirtualMachine))
+}
+
+// issue reports a problem found and counts it.
+func (ctxt *DeffmtContext) issue(err error) {
+	fmt.Fprintln(os.Stderr, err.Error())
+	ctxt.Issues++
+}
@@ -48,6 +48,7 @@ func main() {
 	flag.BoolVar(&wipeall, "wa", false, "Wipes all files under the cache directory before building (implies -ws)")
 	flag.BoolVar(&wipe, "w", false, "Alias for -ws")
 	flag.BoolVar(&verbose, "v", false, "Whether to print additional build details")
+	flag.Var(exe.DefineVarsFlag{}, "D", "Sets a key=value variable to substitute for ${key} in the build configuration (repeatable)")
 	flag.Parse()
 	if confname == "" {
 		flag.Usage()